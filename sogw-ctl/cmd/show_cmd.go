@@ -41,10 +41,12 @@ func showStoreInfo(s store.Store) error {
 		Servers []*meta.Server      `json:"svrs"`
 	}
 	type StoreData struct {
-		Hosts    []*meta.Host  `json:"hosts"`
-		Auths    []*meta.Auth  `json:"auths"`
-		Routes   []*meta.Route `json:"routes"`
-		Services []*Service    `json:"services"`
+		Hosts       []*meta.Host            `json:"hosts"`
+		Auths       []*meta.Auth            `json:"auths"`
+		Routes      []*meta.Route           `json:"routes"`
+		Services    []*Service              `json:"services"`
+		IPAcls      []*meta.IPAcl           `json:"ipacls"`
+		Descriptors []*meta.ProtoDescriptor `json:"descriptors"`
 	}
 	sd := &StoreData{}
 	err := s.GetHosts(func(item *meta.Host) {
@@ -134,6 +136,30 @@ func showStoreInfo(s store.Store) error {
 			return err
 		}
 	}
+	err = s.GetIPAcls(func(item *meta.IPAcl) {
+		if item != nil {
+			if err := item.Valid(); err != nil {
+				log.Warn("[show] [ipacls] : ", err.Error())
+				return
+			}
+			sd.IPAcls = append(sd.IPAcls, item)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = s.GetProtoDescriptors(func(item *meta.ProtoDescriptor) {
+		if item != nil {
+			if err := item.Valid(); err != nil {
+				log.Warn("[show] [descriptors] : ", err.Error())
+				return
+			}
+			sd.Descriptors = append(sd.Descriptors, item)
+		}
+	})
+	if err != nil {
+		return err
+	}
 	fmt.Println(jsonx.MarshalAndIntend(sd))
 	return nil
 }
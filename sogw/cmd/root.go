@@ -31,6 +31,7 @@ var (
 			fs.StringVar(&proxyCfg.Addr, "addr", "", "addr for proxy listen")
 			fs.StringVar(&proxyCfg.TLSAddr, "tls_addr", "", "tls addr for proxy listen (addr,certFile,keyFile)")
 			fs.StringVar(&proxyCfg.UnixAddr, "unix_addr", "", "unix addr for proxy listen")
+			fs.StringVar(&proxyCfg.MetricsAddr, "metrics_addr", "", "addr for prometheus metrics listen")
 			viper.BindPFlags(fs)
 			initMyApiCmd(cmd)
 		},
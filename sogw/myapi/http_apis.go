@@ -46,6 +46,18 @@ func (s *ApiServer) initHttpApis() error {
 	svr.GET("/services/:sid/apis/:id", s.getApi)
 	svr.GET("/services/:sid/apis", s.getApis)
 
+	svr.POST("/ipacls", s.putIPAcl)
+	svr.DELETE("/ipacls/:id", s.removeIPAcl)
+	svr.GET("/ipacls/:id", s.getIPAcl)
+	svr.GET("/ipacls", s.getIPAcls)
+
+	svr.POST("/descriptors", s.putProtoDescriptor)
+	svr.DELETE("/descriptors/:id", s.removeProtoDescriptor)
+	svr.GET("/descriptors/:id", s.getProtoDescriptor)
+	svr.GET("/descriptors", s.getProtoDescriptors)
+
+	svr.POST("/cache/purge", s.purgeCache)
+
 	if s.cfg.HttpAddr == "" {
 		err := errors.New("http addr should not be empty")
 		log.Error("[apisvr] ", err)
@@ -67,7 +79,7 @@ func (s *ApiServer) putHost(ctx echo.Context) error {
 	if err != nil {
 		return nil
 	}
-	if data.Id, err = "-", data.Valid(); err != nil {
+	if err = validWithPlaceholderId(&data.Id, data.Valid); err != nil {
 		s.WriteError(ctx, http.StatusBadRequest, err.Error())
 		return nil
 	}
@@ -129,7 +141,7 @@ func (s *ApiServer) putAuth(ctx echo.Context) error {
 	if err != nil {
 		return nil
 	}
-	if data.Id, err = "-", data.Valid(); err != nil {
+	if err = validWithPlaceholderId(&data.Id, data.Valid); err != nil {
 		s.WriteError(ctx, http.StatusBadRequest, err.Error())
 		return nil
 	}
@@ -191,7 +203,7 @@ func (s *ApiServer) putRoute(ctx echo.Context) error {
 	if err != nil {
 		return nil
 	}
-	if data.Id, err = "-", data.Valid(); err != nil {
+	if err = validWithPlaceholderId(&data.Id, data.Valid); err != nil {
 		s.WriteError(ctx, http.StatusBadRequest, err.Error())
 		return nil
 	}
@@ -253,7 +265,7 @@ func (s *ApiServer) putService(ctx echo.Context) error {
 	if err != nil {
 		return nil
 	}
-	if data.Id, err = "-", data.Valid(); err != nil {
+	if err = validWithPlaceholderId(&data.Id, data.Valid); err != nil {
 		s.WriteError(ctx, http.StatusBadRequest, err.Error())
 		return nil
 	}
@@ -321,7 +333,7 @@ func (s *ApiServer) putServiceCfg(ctx echo.Context) error {
 		return nil
 	}
 
-	if data.Id, err = "-", data.Valid(); err != nil {
+	if err = validWithPlaceholderId(&data.Id, data.Valid); err != nil {
 		s.WriteError(ctx, http.StatusBadRequest, err.Error())
 		return nil
 	}
@@ -376,7 +388,7 @@ func (s *ApiServer) putServer(ctx echo.Context) error {
 		return nil
 	}
 
-	if data.Id, err = "-", data.Valid(); err != nil {
+	if err = validWithPlaceholderId(&data.Id, data.Valid); err != nil {
 		s.WriteError(ctx, http.StatusBadRequest, err.Error())
 		return nil
 	}
@@ -459,7 +471,7 @@ func (s *ApiServer) putApi(ctx echo.Context) error {
 		return nil
 	}
 
-	if data.Id, err = "-", data.Valid(); err != nil {
+	if err = validWithPlaceholderId(&data.Id, data.Valid); err != nil {
 		s.WriteError(ctx, http.StatusBadRequest, err.Error())
 		return nil
 	}
@@ -529,3 +541,149 @@ func (s *ApiServer) getApis(ctx echo.Context) error {
 	s.WriteData(ctx, apis)
 	return nil
 }
+
+func (s *ApiServer) putIPAcl(ctx echo.Context) error {
+	data := &meta.IPAcl{}
+	err := s.ReadJSON(ctx, &data)
+	if err != nil {
+		return nil
+	}
+	// 指定id时更新对应的acl，否则由store生成随机的id
+	if err = validWithPlaceholderId(&data.Id, data.Valid); err != nil {
+		s.WriteError(ctx, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	err = s.store.PutIPAcl(data)
+	if err != nil {
+		log.Error("[apisvr] fail to put ip acl ", err)
+		s.WriteError(ctx, http.StatusInternalServerError, "fail to put ip acl")
+		return nil
+	}
+	s.WriteData(ctx, data)
+	return nil
+}
+func (s *ApiServer) removeIPAcl(ctx echo.Context) error {
+	id := ctx.Param("id")
+	if len(id) <= 0 {
+		s.WriteError(ctx, http.StatusBadRequest, "ip acl id should not be empty")
+		return nil
+	}
+	err := s.store.RemoveIPAcl(id)
+	if err != nil {
+		log.Error("[apisvr] fail to remove ip acl ", err)
+		s.WriteError(ctx, http.StatusInternalServerError, "fail to remove ip acl")
+		return nil
+	}
+	return nil
+}
+func (s *ApiServer) getIPAcl(ctx echo.Context) error {
+	id := ctx.Param("id")
+	if len(id) <= 0 {
+		s.WriteError(ctx, http.StatusBadRequest, "ip acl id should not be empty")
+		return nil
+	}
+	data, err := s.store.GetIPAcl(id)
+	if err != nil {
+		log.Errorf("[apisvr] fail to get ip acl %s , %s", id, err.Error())
+		s.WriteError(ctx, http.StatusInternalServerError, "fail to get ip acl")
+		return nil
+	}
+	s.WriteData(ctx, data)
+	return nil
+}
+func (s *ApiServer) getIPAcls(ctx echo.Context) error {
+	var ipacls []*meta.IPAcl
+	err := s.store.GetIPAcls(func(item *meta.IPAcl) {
+		ipacls = append(ipacls, item)
+	})
+	if err != nil {
+		log.Error("[apisvr] fail to get ip acls ", err)
+		s.WriteError(ctx, http.StatusInternalServerError, "fail to get ip acls")
+		return nil
+	}
+	s.WriteData(ctx, ipacls)
+	return nil
+}
+
+func (s *ApiServer) putProtoDescriptor(ctx echo.Context) error {
+	data := &meta.ProtoDescriptor{}
+	err := s.ReadJSON(ctx, &data)
+	if err != nil {
+		return nil
+	}
+	if err = validWithPlaceholderId(&data.Id, data.Valid); err != nil {
+		s.WriteError(ctx, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	data.Id = ""
+	err = s.store.PutProtoDescriptor(data)
+	if err != nil {
+		log.Error("[apisvr] fail to put proto descriptor ", err)
+		s.WriteError(ctx, http.StatusInternalServerError, "fail to put proto descriptor")
+		return nil
+	}
+	return nil
+}
+func (s *ApiServer) removeProtoDescriptor(ctx echo.Context) error {
+	id := ctx.Param("id")
+	if len(id) <= 0 {
+		s.WriteError(ctx, http.StatusBadRequest, "proto descriptor id should not be empty")
+		return nil
+	}
+	err := s.store.RemoveProtoDescriptor(id)
+	if err != nil {
+		log.Error("[apisvr] fail to remove proto descriptor ", err)
+		s.WriteError(ctx, http.StatusInternalServerError, "fail to remove proto descriptor")
+		return nil
+	}
+	return nil
+}
+func (s *ApiServer) getProtoDescriptor(ctx echo.Context) error {
+	id := ctx.Param("id")
+	if len(id) <= 0 {
+		s.WriteError(ctx, http.StatusBadRequest, "proto descriptor id should not be empty")
+		return nil
+	}
+	data, err := s.store.GetProtoDescriptor(id)
+	if err != nil {
+		log.Errorf("[apisvr] fail to get proto descriptor %s , %s", id, err.Error())
+		s.WriteError(ctx, http.StatusInternalServerError, "fail to get proto descriptor")
+		return nil
+	}
+	s.WriteData(ctx, data)
+	return nil
+}
+func (s *ApiServer) getProtoDescriptors(ctx echo.Context) error {
+	var descs []*meta.ProtoDescriptor
+	err := s.store.GetProtoDescriptors(func(item *meta.ProtoDescriptor) {
+		descs = append(descs, item)
+	})
+	if err != nil {
+		log.Error("[apisvr] fail to get proto descriptors ", err)
+		s.WriteError(ctx, http.StatusInternalServerError, "fail to get proto descriptors")
+		return nil
+	}
+	s.WriteData(ctx, descs)
+	return nil
+}
+
+func (s *ApiServer) purgeCache(ctx echo.Context) error {
+	data := &meta.CachePurge{}
+	err := s.ReadJSON(ctx, &data)
+	if err != nil {
+		return nil
+	}
+	if err = validWithPlaceholderId(&data.Id, data.Valid); err != nil {
+		s.WriteError(ctx, http.StatusBadRequest, err.Error())
+		return nil
+	}
+	data.Id = ""
+	err = s.store.PurgeCache(data)
+	if err != nil {
+		log.Error("[apisvr] fail to purge cache ", err)
+		s.WriteError(ctx, http.StatusInternalServerError, "fail to purge cache")
+		return nil
+	}
+	s.WriteData(ctx, data)
+	return nil
+}
@@ -0,0 +1,75 @@
+package myapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/recallsong/sogw/store"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+)
+
+type purgeStore struct {
+	store.Store
+	purged []*meta.CachePurge
+}
+
+func (s *purgeStore) PurgeCache(p *meta.CachePurge) error {
+	s.purged = append(s.purged, p)
+	return nil
+}
+
+func TestPurgeCache(t *testing.T) {
+	st := &purgeStore{}
+	s := &ApiServer{store: st}
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/cache/purge", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		assert.Nil(t, s.purgeCache(echo.New().NewContext(req, rec)))
+		return rec
+	}
+	rec := post(`{"path":"/x"}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, 1, len(st.purged))
+	assert.Equal(t, "/x", st.purged[0].Path)
+
+	rec = post(`{}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, 1, len(st.purged))
+}
+
+type ipaclStore struct {
+	store.Store
+	acls map[string]*meta.IPAcl
+}
+
+func (s *ipaclStore) PutIPAcl(acl *meta.IPAcl) error {
+	if len(acl.Id) <= 0 {
+		acl.InitId()
+	}
+	s.acls[acl.Id] = acl
+	return nil
+}
+
+func TestPutIPAcl(t *testing.T) {
+	st := &ipaclStore{acls: make(map[string]*meta.IPAcl)}
+	s := &ApiServer{store: st}
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/ipacls", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		assert.Nil(t, s.putIPAcl(echo.New().NewContext(req, rec)))
+		return rec
+	}
+	rec := post(`{"kind":1,"route":"r1","cidrs":["10.0.0.0/8"]}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = post(`{"kind":1,"route":"r1","cidrs":["192.168.0.0/16"]}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, 2, len(st.acls))
+
+	rec = post(`{"id":"acl1","kind":1,"route":"r1","cidrs":["10.0.0.0/8"]}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.NotNil(t, st.acls["acl1"])
+}
@@ -85,6 +85,17 @@ func (s *ApiServer) ReadJSON(ctx echo.Context, out interface{}) error {
 	return nil
 }
 
+// validWithPlaceholderId 新建数据的id由store生成，没有id时使用占位的id校验，校验后恢复原来的id
+func validWithPlaceholderId(id *string, valid func() error) error {
+	old := *id
+	if len(old) <= 0 {
+		*id = "-"
+	}
+	err := valid()
+	*id = old
+	return err
+}
+
 func (s *ApiServer) WriteError(ctx echo.Context, code int, msg string) {
 	ctx.JSON(code, dic.Dic{
 		"code": code,
@@ -0,0 +1,234 @@
+package accesslog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/file-rotatelogs"
+	"github.com/recallsong/sogw/sogw/proxy/core"
+)
+
+type Config struct {
+	Enable bool `mapstructure:"enable"`
+	// file path pattern, e.g. logs/access.%Y%m%d.log, write to stdout if empty
+	Path         string        `mapstructure:"path"`
+	LinkName     string        `mapstructure:"link_name"`
+	RotationTime time.Duration `mapstructure:"rotation_time"`
+	MaxAge       time.Duration `mapstructure:"max_age"`
+	// json or combined
+	Format string   `mapstructure:"format"`
+	Fields []string `mapstructure:"fields"`
+	// ratio of 2xx requests to log, 0 ~ 1, log all if not set
+	Sample2xx float64 `mapstructure:"sample_2xx"`
+}
+
+const (
+	FormatJSON     = "json"
+	FormatCombined = "combined"
+)
+
+// DefaultFields json格式默认输出的字段，延迟的单位为毫秒
+var DefaultFields = []string{
+	"time", "client_ip", "host", "method", "path", "route", "service", "api", "server",
+	"status", "bytes_in", "bytes_out", "upstream_latency", "latency", "request_id", "identity",
+}
+
+var fieldGetters = map[string]func(ctx *core.RequestContext, now time.Time) interface{}{
+	"time":      func(ctx *core.RequestContext, now time.Time) interface{} { return ctx.Start.Format(time.RFC3339Nano) },
+	"client_ip": func(ctx *core.RequestContext, now time.Time) interface{} { return ctx.GetRealClientAddr() },
+	"host":      func(ctx *core.RequestContext, now time.Time) interface{} { return string(ctx.ReqCtx.Host()) },
+	"method":    func(ctx *core.RequestContext, now time.Time) interface{} { return string(ctx.ReqCtx.Method()) },
+	"path":      func(ctx *core.RequestContext, now time.Time) interface{} { return string(ctx.ReqCtx.Path()) },
+	"query": func(ctx *core.RequestContext, now time.Time) interface{} {
+		return string(ctx.ReqCtx.URI().QueryString())
+	},
+	"protocol": func(ctx *core.RequestContext, now time.Time) interface{} {
+		return string(ctx.ReqCtx.Request.Header.Protocol())
+	},
+	"route": func(ctx *core.RequestContext, now time.Time) interface{} {
+		if ctx.Route != nil {
+			return ctx.Route.Meta.Id
+		}
+		return ""
+	},
+	"service": func(ctx *core.RequestContext, now time.Time) interface{} {
+		if ctx.Service != nil {
+			return ctx.Service.Meta.Name
+		}
+		return ""
+	},
+	"api": func(ctx *core.RequestContext, now time.Time) interface{} {
+		if ctx.Api != nil {
+			return ctx.Api.Meta.Id
+		}
+		return ""
+	},
+	"server": func(ctx *core.RequestContext, now time.Time) interface{} {
+		if ctx.Server != nil {
+			return ctx.Server.Meta.Addr
+		}
+		return ""
+	},
+	"status":    func(ctx *core.RequestContext, now time.Time) interface{} { return ctx.ReqCtx.Response.StatusCode() },
+	"bytes_in":  func(ctx *core.RequestContext, now time.Time) interface{} { return bytesIn(ctx) },
+	"bytes_out": func(ctx *core.RequestContext, now time.Time) interface{} { return bytesOut(ctx) },
+	"upstream_latency": func(ctx *core.RequestContext, now time.Time) interface{} {
+		return millis(ctx.UpstreamCost)
+	},
+	"latency":    func(ctx *core.RequestContext, now time.Time) interface{} { return millis(now.Sub(ctx.Start)) },
+	"request_id": func(ctx *core.RequestContext, now time.Time) interface{} { return ctx.RequestId },
+	"identity":   func(ctx *core.RequestContext, now time.Time) interface{} { return ctx.Identity },
+	"referer":    func(ctx *core.RequestContext, now time.Time) interface{} { return string(ctx.ReqCtx.Referer()) },
+	"user_agent": func(ctx *core.RequestContext, now time.Time) interface{} { return string(ctx.ReqCtx.UserAgent()) },
+}
+
+type Logger struct {
+	out    io.Writer
+	closer io.Closer
+	format string
+	fields []string
+	sample float64
+	rnd    *rand.Rand
+	lock   sync.Mutex
+}
+
+func New(cfg *Config) (*Logger, error) {
+	l := &Logger{
+		format: cfg.Format,
+		fields: cfg.Fields,
+		sample: cfg.Sample2xx,
+		rnd:    rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	if len(l.format) <= 0 {
+		l.format = FormatJSON
+	}
+	if l.format != FormatJSON && l.format != FormatCombined {
+		return nil, fmt.Errorf("access log format %s not support", l.format)
+	}
+	if len(l.fields) <= 0 {
+		l.fields = DefaultFields
+	}
+	for _, f := range l.fields {
+		if _, ok := fieldGetters[f]; !ok {
+			return nil, fmt.Errorf("access log field %s not support", f)
+		}
+	}
+	if len(cfg.Path) <= 0 {
+		l.out = os.Stdout
+		return l, nil
+	}
+	var opts []rotatelogs.Option
+	if len(cfg.LinkName) > 0 {
+		opts = append(opts, rotatelogs.WithLinkName(cfg.LinkName))
+	}
+	if cfg.RotationTime > 0 {
+		opts = append(opts, rotatelogs.WithRotationTime(cfg.RotationTime))
+	}
+	if cfg.MaxAge > 0 {
+		opts = append(opts, rotatelogs.WithMaxAge(cfg.MaxAge))
+	}
+	rl, err := rotatelogs.New(cfg.Path, opts...)
+	if err != nil {
+		return nil, err
+	}
+	l.out, l.closer = rl, rl
+	return l, nil
+}
+
+func (l *Logger) Log(ctx *core.RequestContext) {
+	if l == nil || !l.sampled(ctx.ReqCtx.Response.StatusCode()) {
+		return
+	}
+	now := time.Now()
+	buf := &bytes.Buffer{}
+	if l.format == FormatCombined {
+		writeCombined(buf, ctx)
+	} else {
+		l.writeJSON(buf, ctx, now)
+	}
+	buf.WriteByte('\n')
+	l.out.Write(buf.Bytes())
+}
+
+func (l *Logger) Close() error {
+	if l == nil || l.closer == nil {
+		return nil
+	}
+	return l.closer.Close()
+}
+
+func (l *Logger) sampled(status int) bool {
+	if status < 200 || status >= 300 || l.sample <= 0 || l.sample >= 1 {
+		return true
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.rnd.Float64() < l.sample
+}
+
+func (l *Logger) writeJSON(buf *bytes.Buffer, ctx *core.RequestContext, now time.Time) {
+	buf.WriteByte('{')
+	for i, f := range l.fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(strconv.Quote(f))
+		buf.WriteByte(':')
+		val, err := json.Marshal(fieldGetters[f](ctx, now))
+		if err != nil {
+			buf.WriteString("null")
+			continue
+		}
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+}
+
+// writeCombined Apache combined格式：host ident user [time] "request" status bytes "referer" "user-agent"
+func writeCombined(buf *bytes.Buffer, ctx *core.RequestContext) {
+	reqc := ctx.ReqCtx
+	user := ctx.Identity
+	if len(user) <= 0 {
+		user = "-"
+	}
+	fmt.Fprintf(buf, "%s - %s [%s] \"%s %s %s\" %d %d %q %q",
+		ctx.GetRealClientAddr(), user, ctx.Start.Format("02/Jan/2006:15:04:05 -0700"),
+		reqc.Method(), reqc.RequestURI(), reqc.Request.Header.Protocol(),
+		reqc.Response.StatusCode(), bytesOut(ctx), reqc.Referer(), reqc.UserAgent())
+}
+
+// bytesIn 使用Content-Length，请求被拒绝时可能还未读取body，不能读取以流的方式接收的body
+func bytesIn(ctx *core.RequestContext) int {
+	req := &ctx.ReqCtx.Request
+	n := req.Header.ContentLength()
+	if n < 0 {
+		n = 0
+		if !req.IsBodyStream() {
+			n = len(req.Body())
+		}
+	}
+	return len(req.Header.Header()) + n
+}
+
+// bytesOut 流式响应在记录日志之后才写给客户端，不能读取其body，使用Content-Length，长度未知时为0
+func bytesOut(ctx *core.RequestContext) int {
+	resp := &ctx.ReqCtx.Response
+	if !resp.IsBodyStream() {
+		return len(resp.Body())
+	}
+	if n := resp.Header.ContentLength(); n > 0 {
+		return n
+	}
+	return 0
+}
+
+func millis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
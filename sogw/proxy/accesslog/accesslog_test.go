@@ -0,0 +1,106 @@
+package accesslog
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func newTestContext(status int) *core.RequestContext {
+	var req fasthttp.Request
+	req.SetRequestURI("/v1/users?page=1")
+	req.SetHost("api.example.com")
+	req.Header.Set("X-Request-Id", "abc")
+	req.Header.Set("User-Agent", "curl/7.0")
+	reqc := &fasthttp.RequestCtx{}
+	reqc.Init(&req, &net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 1234}, nil)
+	reqc.SetStatusCode(status)
+	reqc.SetBodyString("hello")
+	ctx := core.NewRequestContext(reqc)
+	ctx.InitRequestId(core.DefaultRequestIdHeader)
+	ctx.Identity = "recall"
+	return ctx
+}
+
+func TestLogJSON(t *testing.T) {
+	l, err := New(&Config{Fields: []string{"client_ip", "method", "path", "status", "bytes_out", "request_id", "identity"}})
+	assert.Nil(t, err)
+	buf := &bytes.Buffer{}
+	l.out = buf
+	l.Log(newTestContext(200))
+	assert.True(t, strings.HasPrefix(buf.String(), `{"client_ip":"1.2.3.4","method":"GET",`))
+	var entry map[string]interface{}
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "/v1/users", entry["path"])
+	assert.Equal(t, float64(200), entry["status"])
+	assert.Equal(t, float64(5), entry["bytes_out"])
+	assert.Equal(t, "abc", entry["request_id"])
+	assert.Equal(t, "recall", entry["identity"])
+}
+
+func TestLogCombined(t *testing.T) {
+	l, err := New(&Config{Format: FormatCombined})
+	assert.Nil(t, err)
+	buf := &bytes.Buffer{}
+	l.out = buf
+	l.Log(newTestContext(404))
+	line := buf.String()
+	assert.True(t, strings.HasPrefix(line, "1.2.3.4 - recall ["), line)
+	assert.True(t, strings.HasSuffix(line, "\"GET /v1/users?page=1 HTTP/1.1\" 404 5 \"\" \"curl/7.0\"\n"), line)
+}
+
+func TestSample2xx(t *testing.T) {
+	l, err := New(&Config{Sample2xx: 0.0001})
+	assert.Nil(t, err)
+	buf := &bytes.Buffer{}
+	l.out = buf
+	l.Log(newTestContext(500))
+	assert.True(t, buf.Len() > 0)
+
+	_, err = New(&Config{Fields: []string{"unknown"}})
+	assert.NotNil(t, err)
+	_, err = New(&Config{Format: "xml"})
+	assert.NotNil(t, err)
+}
+
+func TestLogStreamResponse(t *testing.T) {
+	l, err := New(&Config{Fields: []string{"status", "bytes_out"}})
+	assert.Nil(t, err)
+	buf := &bytes.Buffer{}
+	l.out = buf
+	ctx := newTestContext(200)
+	// the stream never ends, Log must not read it
+	r, w := io.Pipe()
+	defer w.Close()
+	ctx.ReqCtx.Response.SetBodyStream(r, -1)
+	l.Log(ctx)
+	assert.Equal(t, `{"status":200,"bytes_out":0}`+"\n", buf.String())
+
+	buf.Reset()
+	ctx = newTestContext(200)
+	ctx.ReqCtx.Response.SetBodyStream(r, 128)
+	l.Log(ctx)
+	assert.Equal(t, `{"status":200,"bytes_out":128}`+"\n", buf.String())
+}
+
+func TestLogStreamRequest(t *testing.T) {
+	l, err := New(&Config{Fields: []string{"bytes_in"}})
+	assert.Nil(t, err)
+	buf := &bytes.Buffer{}
+	l.out = buf
+	ctx := newTestContext(401)
+	r, w := io.Pipe()
+	defer w.Close()
+	ctx.ReqCtx.Request.SetBodyStream(r, 1<<20)
+	header := len(ctx.ReqCtx.Request.Header.Header())
+	l.Log(ctx)
+	assert.Equal(t, `{"bytes_in":`+strconv.Itoa(header+1<<20)+"}\n", buf.String())
+}
@@ -0,0 +1,247 @@
+package cache
+
+import (
+	"bytes"
+	"strconv"
+	"time"
+
+	"github.com/recallsong/cliframe/cobrax"
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/sogw/proxy/metrics"
+	"github.com/recallsong/sogw/store/meta"
+	log "github.com/sirupsen/logrus"
+	"github.com/valyala/fasthttp"
+)
+
+const DefaultMaxSize = 64 << 20
+
+type Config struct {
+	Enable bool `mapstructure:"enable"`
+	// memory budget of cached responses in bytes, default 64MB
+	MaxSize int64 `mapstructure:"max_size"`
+	// ttl for responses without Cache-Control or Expires, not cache them if 0
+	DefaultTTL           time.Duration `mapstructure:"default_ttl"`
+	StaleWhileRevalidate time.Duration `mapstructure:"stale_while_revalidate"`
+}
+
+const lookupAttrKey = "_cache.lookup"
+
+// lookup 一次请求的缓存查找结果，在Start和End之间传递
+type lookup struct {
+	primary string
+	key     string
+	host    string
+	path    string
+	apiId   string
+	ttl     time.Duration
+	swr     time.Duration
+	hit     bool
+}
+
+// Cache 响应缓存，作为HookPair注册在BeforeDispatch上
+type Cache struct {
+	cfg *Config
+	lru *lru
+}
+
+func New(cfg *Config) *Cache {
+	max := cfg.MaxSize
+	if max <= 0 {
+		max = DefaultMaxSize
+	}
+	return &Cache{
+		cfg: cfg,
+		lru: newLRU(max),
+	}
+}
+
+func (c *Cache) Start(ctx *core.RequestContext) error {
+	if ctx.Api == nil || len(ctx.Api.Meta.Lambda) > 0 || ctx.ForwardReq == nil {
+		return nil
+	}
+	cfg := ctx.Api.Meta.Cache
+	if cfg != nil && cfg.Disable {
+		return nil
+	}
+	if len(ctx.Api.AuthId(ctx)) > 0 {
+		// responses of authenticated apis depend on the identity
+		return nil
+	}
+	reqc := ctx.ReqCtx
+	if !reqc.IsGet() && !reqc.IsHead() || core.IsWebSocket(&reqc.Request.Header) {
+		return nil
+	}
+	canLookup, canStore := requestBypass(&reqc.Request.Header)
+	if !canStore {
+		metrics.CacheLookups.WithLabelValues("bypass").Inc()
+		return nil
+	}
+	primary := primaryKey(ctx, cfg)
+	lk := &lookup{
+		primary: primary,
+		key:     variantKey(primary, c.lru.vary(primary), &reqc.Request.Header),
+		host:    string(reqc.Host()),
+		path:    string(reqc.Path()),
+		apiId:   ctx.Api.Meta.Id,
+	}
+	if cfg != nil {
+		lk.ttl = time.Duration(cfg.Ttl) * time.Second
+		lk.swr = time.Duration(cfg.StaleWhileRevalidate) * time.Second
+	}
+	ctx.SetAttr(lookupAttrKey, lk)
+	if !canLookup {
+		metrics.CacheLookups.WithLabelValues("bypass").Inc()
+		return nil
+	}
+	now := time.Now()
+	e := c.lru.get(lk.key)
+	switch {
+	case e != nil && e.fresh(now):
+		lk.hit = true
+		c.serve(ctx, e, now, "HIT")
+	case e != nil && e.stale(now):
+		lk.hit = true
+		c.serve(ctx, e, now, "STALE")
+		c.revalidate(ctx, lk, e)
+	default:
+		metrics.CacheLookups.WithLabelValues("miss").Inc()
+	}
+	return nil
+}
+
+func (c *Cache) End(ctx *core.RequestContext) error {
+	lk, _ := ctx.Attrs[lookupAttrKey].(*lookup)
+	if lk == nil || lk.hit || ctx.Err != nil || ctx.ForwardResp == nil || ctx.ForwardResp.IsBodyStream() {
+		return nil
+	}
+	c.store(lk, &ctx.ReqCtx.Request.Header, ctx.ForwardResp, time.Now())
+	ctx.ForwardResp.Header.Set("X-Cache", "MISS")
+	return nil
+}
+
+// Purge 清除匹配的缓存，返回清除的条目数
+func (c *Cache) Purge(p *meta.CachePurge) int {
+	if c == nil {
+		return 0
+	}
+	return c.lru.purge(p)
+}
+
+func (c *Cache) serve(ctx *core.RequestContext, e *entry, now time.Time, result string) {
+	metrics.CacheLookups.WithLabelValues(result).Inc()
+	resp := fasthttp.AcquireResponse()
+	e.header.CopyTo(&resp.Header)
+	resp.SetBody(e.body)
+	resp.Header.Set("Age", strconv.FormatInt(int64(now.Sub(e.stored)/time.Second), 10))
+	resp.Header.Set("X-Cache", result)
+	ctx.ForwardResp = resp
+	if cobrax.Flags.Debug {
+		log.Debugf("[cache] [%s] %s %s", ctx.RequestId, result, e.key)
+	}
+}
+
+// revalidate 在后台向服务器重新请求，更新过期的缓存
+func (c *Cache) revalidate(ctx *core.RequestContext, lk *lookup, e *entry) {
+	svr := ctx.Server
+	if svr == nil || !c.lru.startRevalidate(e) {
+		return
+	}
+	req := fasthttp.AcquireRequest()
+	ctx.ForwardReq.CopyTo(req)
+	req.Header.Del("If-None-Match")
+	req.Header.Del("If-Modified-Since")
+	header := &fasthttp.RequestHeader{}
+	ctx.ReqCtx.Request.Header.CopyTo(header)
+	reqId := ctx.RequestId
+	go func() {
+		defer c.lru.endRevalidate(e)
+		resp := fasthttp.AcquireResponse()
+		defer func() {
+			fasthttp.ReleaseRequest(req)
+			fasthttp.ReleaseResponse(resp)
+		}()
+		if err := svr.Forward(req, resp); err != nil {
+			log.Errorf("[cache] [%s] revalidate %s error : %v", reqId, e.key, err)
+			return
+		}
+		c.store(lk, header, resp, time.Now())
+	}()
+}
+
+func (c *Cache) store(lk *lookup, req *fasthttp.RequestHeader, resp *fasthttp.Response, now time.Time) {
+	ttl, swr, ok := freshness(req, &resp.Header, now, c.cfg.DefaultTTL)
+	if !ok {
+		return
+	}
+	if lk.ttl > 0 {
+		ttl = lk.ttl
+	}
+	if ttl <= 0 {
+		return
+	}
+	if lk.swr > 0 {
+		swr = lk.swr
+	} else if swr <= 0 {
+		swr = c.cfg.StaleWhileRevalidate
+	}
+	vary, _ := varyHeaders(&resp.Header)
+	header := &fasthttp.ResponseHeader{}
+	resp.Header.CopyTo(header)
+	header.Del("X-Cache")
+	header.Del("Age")
+	body := append([]byte(nil), resp.Body()...)
+	e := &entry{
+		key:        variantKey(lk.primary, vary, req),
+		primary:    lk.primary,
+		host:       lk.host,
+		path:       lk.path,
+		apiId:      lk.apiId,
+		header:     header,
+		body:       body,
+		stored:     now,
+		expires:    now.Add(ttl),
+		staleUntil: now.Add(ttl + swr),
+	}
+	e.size = int64(len(e.key) + len(header.Header()) + len(body))
+	c.lru.add(e, vary)
+}
+
+// primaryKey 缓存主键：method host path，加上配置的keys的值，没有配置keys时使用完整的查询参数
+func primaryKey(ctx *core.RequestContext, cfg *meta.CacheConfig) string {
+	reqc := ctx.ReqCtx
+	buf := &bytes.Buffer{}
+	buf.Write(reqc.Method())
+	buf.WriteByte(' ')
+	buf.Write(reqc.Host())
+	buf.Write(reqc.Path())
+	if cfg == nil || len(cfg.Keys) <= 0 {
+		if qs := reqc.URI().QueryString(); len(qs) > 0 {
+			buf.WriteByte('?')
+			buf.Write(qs)
+		}
+		return buf.String()
+	}
+	for _, item := range cfg.Keys {
+		val, _ := core.GetValue(ctx, item)
+		buf.WriteByte('|')
+		buf.WriteString(item.Name)
+		buf.WriteByte('=')
+		buf.WriteString(val)
+	}
+	return buf.String()
+}
+
+// variantKey 在主键上加入Vary请求头的值
+func variantKey(primary string, vary []string, h *fasthttp.RequestHeader) string {
+	if len(vary) <= 0 {
+		return primary
+	}
+	buf := bytes.NewBufferString(primary)
+	for _, name := range vary {
+		buf.WriteByte('\n')
+		buf.WriteString(name)
+		buf.WriteByte(':')
+		buf.Write(h.Peek(name))
+	}
+	return buf.String()
+}
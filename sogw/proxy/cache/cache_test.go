@@ -0,0 +1,179 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestFreshness(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	var req fasthttp.RequestHeader
+	var resp fasthttp.ResponseHeader
+	resp.Set("Cache-Control", "public, max-age=60, stale-while-revalidate=10")
+	ttl, swr, ok := freshness(&req, &resp, now, 0)
+	assert.True(t, ok)
+	assert.Equal(t, 60*time.Second, ttl)
+	assert.Equal(t, 10*time.Second, swr)
+
+	resp.Set("Cache-Control", "max-age=60, s-maxage=30")
+	resp.Set("Age", "10")
+	ttl, _, _ = freshness(&req, &resp, now, 0)
+	assert.Equal(t, 20*time.Second, ttl)
+
+	resp.Reset()
+	resp.Set("Date", string(fasthttp.AppendHTTPDate(nil, now)))
+	resp.Set("Expires", string(fasthttp.AppendHTTPDate(nil, now.Add(time.Minute))))
+	ttl, _, ok = freshness(&req, &resp, now, 0)
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, ttl)
+
+	resp.Reset()
+	ttl, _, ok = freshness(&req, &resp, now, 5*time.Second)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, ttl)
+
+	for _, cc := range []string{"no-store", "private, max-age=60", "no-cache"} {
+		resp.Reset()
+		resp.Set("Cache-Control", cc)
+		_, _, ok = freshness(&req, &resp, now, time.Minute)
+		assert.False(t, ok, cc)
+	}
+	resp.Reset()
+	resp.Set("Vary", "*")
+	_, _, ok = freshness(&req, &resp, now, time.Minute)
+	assert.False(t, ok)
+
+	resp.Reset()
+	req.Set("Authorization", "Basic xxx")
+	_, _, ok = freshness(&req, &resp, now, time.Minute)
+	assert.False(t, ok)
+}
+
+func TestLRU(t *testing.T) {
+	l := newLRU(100)
+	for _, key := range []string{"a", "b", "c"} {
+		assert.True(t, l.add(&entry{key: key, primary: key, host: "h", path: "/" + key, size: 40}, nil))
+	}
+	assert.Equal(t, 2, l.len())
+	assert.Nil(t, l.get("a"))
+	assert.NotNil(t, l.get("b"))
+	assert.False(t, l.add(&entry{key: "d", size: 101}, nil))
+
+	assert.Equal(t, 0, l.purge(&meta.CachePurge{Host: "other"}))
+	assert.Equal(t, 1, l.purge(&meta.CachePurge{Path: "/c"}))
+	assert.Equal(t, 1, l.purge(&meta.CachePurge{Host: "h"}))
+	assert.Equal(t, 0, l.len())
+}
+
+func TestLRUVaries(t *testing.T) {
+	l := newLRU(100)
+	vary := []string{"Accept-Encoding"}
+	assert.True(t, l.add(&entry{key: "a|gzip", primary: "a", path: "/a", size: 30}, vary))
+	assert.True(t, l.add(&entry{key: "a|br", primary: "a", path: "/a", size: 30}, vary))
+	assert.Equal(t, vary, l.vary("a"))
+	assert.True(t, l.add(&entry{key: "b", primary: "b", path: "/b", size: 60}, nil))
+	assert.Equal(t, vary, l.vary("a"))
+	assert.True(t, l.add(&entry{key: "c", primary: "c", path: "/c", size: 40}, nil))
+	assert.Nil(t, l.vary("a"))
+	assert.Equal(t, 1, l.purge(&meta.CachePurge{Path: "/c"}))
+	assert.Equal(t, 1, l.purge(&meta.CachePurge{Path: "/"}))
+	assert.Equal(t, 0, len(l.varies))
+}
+
+func newTestContext(uri string) *core.RequestContext {
+	reqc := &fasthttp.RequestCtx{}
+	reqc.Request.SetRequestURI(uri)
+	reqc.Request.SetHost("example.com")
+	ctx := core.NewRequestContext(reqc)
+	ctx.Api = &core.Api{Meta: &meta.Api{Id: "api1"}}
+	ctx.ForwardReq = &fasthttp.Request{}
+	return ctx
+}
+
+func TestCacheHit(t *testing.T) {
+	c := New(&Config{Enable: true})
+
+	ctx := newTestContext("/users?page=1")
+	assert.Nil(t, c.Start(ctx))
+	assert.Nil(t, ctx.ForwardResp)
+	ctx.ForwardResp = &fasthttp.Response{}
+	ctx.ForwardResp.Header.Set("Cache-Control", "max-age=60")
+	ctx.ForwardResp.Header.Set("Vary", "Accept-Language")
+	ctx.ForwardResp.SetBodyString("hello")
+	assert.Nil(t, c.End(ctx))
+	assert.Equal(t, "MISS", string(ctx.ForwardResp.Header.Peek("X-Cache")))
+
+	ctx = newTestContext("/users?page=1")
+	assert.Nil(t, c.Start(ctx))
+	assert.NotNil(t, ctx.ForwardResp)
+	assert.Equal(t, "HIT", string(ctx.ForwardResp.Header.Peek("X-Cache")))
+	assert.Equal(t, "hello", string(ctx.ForwardResp.Body()))
+
+	ctx = newTestContext("/users?page=1")
+	ctx.ReqCtx.Request.Header.Set("Accept-Language", "zh")
+	assert.Nil(t, c.Start(ctx))
+	assert.Nil(t, ctx.ForwardResp)
+
+	ctx = newTestContext("/users?page=2")
+	assert.Nil(t, c.Start(ctx))
+	assert.Nil(t, ctx.ForwardResp)
+
+	ctx = newTestContext("/users?page=1")
+	ctx.ReqCtx.Request.Header.Set("Cache-Control", "no-cache")
+	assert.Nil(t, c.Start(ctx))
+	assert.Nil(t, ctx.ForwardResp)
+
+	assert.Equal(t, 1, c.Purge(&meta.CachePurge{ApiId: "api1"}))
+	ctx = newTestContext("/users?page=1")
+	assert.Nil(t, c.Start(ctx))
+	assert.Nil(t, ctx.ForwardResp)
+}
+
+func TestCacheApiConfig(t *testing.T) {
+	c := New(&Config{Enable: true})
+	cfg := &meta.CacheConfig{
+		Ttl:  30,
+		Keys: []*meta.ValueItem{{Source: meta.ValueSource_ReqQueryParam, Name: "id"}},
+	}
+	ctx := newTestContext("/users?id=1&t=1")
+	ctx.Api.Meta.Cache = cfg
+	assert.Nil(t, c.Start(ctx))
+	ctx.ForwardResp = &fasthttp.Response{}
+	ctx.ForwardResp.SetBodyString("user1")
+	assert.Nil(t, c.End(ctx))
+
+	ctx = newTestContext("/users?id=1&t=2")
+	ctx.Api.Meta.Cache = cfg
+	assert.Nil(t, c.Start(ctx))
+	assert.NotNil(t, ctx.ForwardResp)
+	assert.Equal(t, "user1", string(ctx.ForwardResp.Body()))
+
+	ctx = newTestContext("/users?id=1")
+	ctx.Api.Meta.Cache = &meta.CacheConfig{Disable: true}
+	assert.Nil(t, c.Start(ctx))
+	assert.Nil(t, ctx.ForwardResp)
+}
+
+func TestCacheAuth(t *testing.T) {
+	c := New(&Config{Enable: true})
+	for i := 0; i < 2; i++ {
+		ctx := newTestContext("/users")
+		ctx.Api.Meta.AuthId = "basic"
+		assert.Nil(t, c.Start(ctx))
+		assert.Nil(t, ctx.ForwardResp)
+		ctx.ForwardResp = &fasthttp.Response{}
+		ctx.ForwardResp.Header.Set("Cache-Control", "max-age=60")
+		assert.Nil(t, c.End(ctx))
+	}
+	assert.Equal(t, 0, c.lru.len())
+
+	ctx := newTestContext("/users")
+	ctx.Service = &core.Service{Config: &meta.ServiceConfig{AuthId: "basic"}}
+	assert.Nil(t, c.Start(ctx))
+	assert.Nil(t, ctx.Attrs[lookupAttrKey])
+}
@@ -0,0 +1,162 @@
+package cache
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/valyala/fasthttp"
+)
+
+type entry struct {
+	key     string
+	primary string
+	host    string
+	path    string
+	apiId   string
+
+	header     *fasthttp.ResponseHeader
+	body       []byte
+	stored     time.Time
+	expires    time.Time
+	staleUntil time.Time
+	size       int64
+
+	revalidating bool
+}
+
+func (e *entry) fresh(now time.Time) bool {
+	return now.Before(e.expires)
+}
+
+func (e *entry) stale(now time.Time) bool {
+	return !e.fresh(now) && now.Before(e.staleUntil)
+}
+
+// lru 按照内存大小淘汰的LRU，varies记录每个主键对应的Vary请求头，主键的条目全部移除时一起删除
+type lru struct {
+	lock   sync.Mutex
+	max    int64
+	size   int64
+	ll     *list.List
+	items  map[string]*list.Element
+	varies map[string]*variants
+}
+
+// variants 主键的Vary请求头以及缓存条目的数量
+type variants struct {
+	vary []string
+	n    int
+}
+
+func newLRU(max int64) *lru {
+	return &lru{
+		max:    max,
+		ll:     list.New(),
+		items:  make(map[string]*list.Element),
+		varies: make(map[string]*variants),
+	}
+}
+
+func (l *lru) vary(primary string) []string {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if v, ok := l.varies[primary]; ok {
+		return v.vary
+	}
+	return nil
+}
+
+func (l *lru) get(key string) *entry {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if el, ok := l.items[key]; ok {
+		l.ll.MoveToFront(el)
+		return el.Value.(*entry)
+	}
+	return nil
+}
+
+func (l *lru) add(e *entry, vary []string) bool {
+	if e.size > l.max {
+		return false
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if el, ok := l.items[e.key]; ok {
+		l.removeElement(el)
+	}
+	v, ok := l.varies[e.primary]
+	if !ok {
+		v = &variants{}
+		l.varies[e.primary] = v
+	}
+	v.vary = vary
+	v.n++
+	l.items[e.key] = l.ll.PushFront(e)
+	l.size += e.size
+	for l.size > l.max {
+		last := l.ll.Back()
+		if last == nil {
+			break
+		}
+		l.removeElement(last)
+	}
+	return true
+}
+
+// startRevalidate 标记条目正在重新验证，避免同一条目并发回源
+func (l *lru) startRevalidate(e *entry) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if e.revalidating {
+		return false
+	}
+	e.revalidating = true
+	return true
+}
+
+func (l *lru) endRevalidate(e *entry) {
+	l.lock.Lock()
+	e.revalidating = false
+	l.lock.Unlock()
+}
+
+// purge 清除匹配的条目，host、apiId为空表示不限制，path按前缀匹配
+func (l *lru) purge(p *meta.CachePurge) int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	n := 0
+	for el := l.ll.Front(); el != nil; {
+		next := el.Next()
+		e := el.Value.(*entry)
+		if (len(p.Host) <= 0 || p.Host == e.host) &&
+			(len(p.ApiId) <= 0 || p.ApiId == e.apiId) &&
+			strings.HasPrefix(e.path, p.Path) {
+			l.removeElement(el)
+			n++
+		}
+		el = next
+	}
+	return n
+}
+
+func (l *lru) removeElement(el *list.Element) {
+	e := l.ll.Remove(el).(*entry)
+	delete(l.items, e.key)
+	l.size -= e.size
+	if v, ok := l.varies[e.primary]; ok {
+		v.n--
+		if v.n <= 0 {
+			delete(l.varies, e.primary)
+		}
+	}
+}
+
+func (l *lru) len() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.ll.Len()
+}
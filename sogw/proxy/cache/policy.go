@@ -0,0 +1,141 @@
+package cache
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/recallsong/go-utils/reflectx"
+	"github.com/valyala/fasthttp"
+)
+
+// cacheableStatus 默认可以缓存的状态码，参考 RFC 7231 6.1
+var cacheableStatus = map[int]bool{
+	fasthttp.StatusOK:                   true,
+	fasthttp.StatusNonAuthoritativeInfo: true,
+	fasthttp.StatusNoContent:            true,
+	fasthttp.StatusMultipleChoices:      true,
+	fasthttp.StatusMovedPermanently:     true,
+	fasthttp.StatusNotFound:             true,
+	fasthttp.StatusMethodNotAllowed:     true,
+	fasthttp.StatusGone:                 true,
+	fasthttp.StatusNotImplemented:       true,
+}
+
+type directives map[string]string
+
+// parseCacheControl 解析 Cache-Control 头，指令名转为小写
+func parseCacheControl(val []byte) directives {
+	if len(val) <= 0 {
+		return nil
+	}
+	ds := make(directives)
+	for _, part := range strings.Split(reflectx.BytesToString(val), ",") {
+		part = strings.TrimSpace(part)
+		if len(part) <= 0 {
+			continue
+		}
+		name, value := part, ""
+		if idx := strings.IndexByte(part, '='); idx >= 0 {
+			name, value = part[:idx], strings.Trim(strings.TrimSpace(part[idx+1:]), "\"")
+		}
+		ds[strings.ToLower(strings.TrimSpace(name))] = value
+	}
+	return ds
+}
+
+func (ds directives) has(name string) bool {
+	_, ok := ds[name]
+	return ok
+}
+
+func (ds directives) seconds(name string) (time.Duration, bool) {
+	val, ok := ds[name]
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(val, 10, 64)
+	if err != nil || n < 0 {
+		return 0, true
+	}
+	return time.Duration(n) * time.Second, true
+}
+
+// requestBypass 请求是否跳过缓存，lookup表示是否可以读缓存，store表示是否可以写缓存
+func requestBypass(h *fasthttp.RequestHeader) (lookup, store bool) {
+	ds := parseCacheControl(h.Peek("Cache-Control"))
+	if ds.has("no-store") {
+		return false, false
+	}
+	if ds.has("no-cache") || string(h.Peek("Pragma")) == "no-cache" {
+		return false, true
+	}
+	if age, ok := ds.seconds("max-age"); ok && age <= 0 {
+		return false, true
+	}
+	return true, true
+}
+
+// freshness 计算响应的新鲜时间，ttl为0表示不能缓存；
+// 如果响应没有给出过期信息，使用defaultTTL
+func freshness(req *fasthttp.RequestHeader, resp *fasthttp.ResponseHeader, now time.Time, defaultTTL time.Duration) (ttl, swr time.Duration, ok bool) {
+	if !cacheableStatus[resp.StatusCode()] {
+		return 0, 0, false
+	}
+	ds := parseCacheControl(resp.Peek("Cache-Control"))
+	if ds.has("no-store") || ds.has("no-cache") || ds.has("private") {
+		return 0, 0, false
+	}
+	if len(resp.Peek("Set-Cookie")) > 0 {
+		return 0, 0, false
+	}
+	if (len(req.Peek("Authorization")) > 0 || len(req.Peek("Authentication")) > 0) && !ds.has("public") && !ds.has("s-maxage") {
+		return 0, 0, false
+	}
+	if _, ok := varyHeaders(resp); !ok {
+		return 0, 0, false
+	}
+	swr, _ = ds.seconds("stale-while-revalidate")
+	if v, ok := ds.seconds("s-maxage"); ok {
+		ttl = v
+	} else if v, ok := ds.seconds("max-age"); ok {
+		ttl = v
+	} else if expires := resp.Peek("Expires"); len(expires) > 0 {
+		t, err := fasthttp.ParseHTTPDate(expires)
+		if err != nil {
+			return 0, 0, false
+		}
+		date := now
+		if d, err := fasthttp.ParseHTTPDate(resp.Peek("Date")); err == nil {
+			date = d
+		}
+		ttl = t.Sub(date)
+	} else {
+		ttl = defaultTTL
+	}
+	if age, err := strconv.ParseInt(string(resp.Peek("Age")), 10, 64); err == nil && age > 0 {
+		ttl -= time.Duration(age) * time.Second
+	}
+	return ttl, swr, true
+}
+
+// varyHeaders 返回排序后的Vary请求头名称，Vary: * 的响应不能缓存
+func varyHeaders(resp *fasthttp.ResponseHeader) ([]string, bool) {
+	val := resp.Peek("Vary")
+	if len(val) <= 0 {
+		return nil, true
+	}
+	var names []string
+	for _, name := range strings.Split(reflectx.BytesToString(val), ",") {
+		name = strings.TrimSpace(name)
+		if name == "*" {
+			return nil, false
+		}
+		if len(name) > 0 {
+			names = append(names, strings.ToLower(name))
+		}
+	}
+	sort.Strings(names)
+	return names, true
+}
@@ -0,0 +1,230 @@
+package compress
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/recallsong/go-utils/reflectx"
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	DefaultMinSize             = 1024
+	DefaultMaxDecompressedSize = 10 << 20
+	// 超过该大小的静态文件以流的方式发送，不压缩
+	maxFileSize = 8 << 20
+)
+
+// DefaultEncodings 支持的压缩算法，客户端权重相同时按顺序优先
+var DefaultEncodings = []string{"br", "gzip", "deflate"}
+
+// DefaultContentTypes 允许压缩的Content-Type，按前缀匹配
+var DefaultContentTypes = []string{
+	"text/",
+	"application/json",
+	"application/javascript",
+	"application/xml",
+	"application/problem+json",
+	"image/svg+xml",
+}
+
+type Config struct {
+	Enable bool `mapstructure:"enable"`
+	// do not compress responses smaller than this, default 1024 bytes
+	MinSize      int      `mapstructure:"min_size"`
+	ContentTypes []string `mapstructure:"content_types"`
+	Encodings    []string `mapstructure:"encodings"`
+	// decompress request bodies encoded with gzip, deflate or br
+	DecompressRequest   bool `mapstructure:"decompress_request"`
+	MaxDecompressedSize int  `mapstructure:"max_decompressed_size"`
+}
+
+type Compressor struct {
+	minSize   int
+	types     []string
+	encodings []string
+	maxSize   int
+}
+
+func New(cfg *Config) (*Compressor, error) {
+	c := &Compressor{
+		minSize:   cfg.MinSize,
+		types:     cfg.ContentTypes,
+		encodings: cfg.Encodings,
+		maxSize:   cfg.MaxDecompressedSize,
+	}
+	if c.minSize <= 0 {
+		c.minSize = DefaultMinSize
+	}
+	if len(c.types) <= 0 {
+		c.types = DefaultContentTypes
+	}
+	if len(c.encodings) <= 0 {
+		c.encodings = DefaultEncodings
+	}
+	if c.maxSize <= 0 {
+		c.maxSize = DefaultMaxDecompressedSize
+	}
+	for _, enc := range c.encodings {
+		if enc != "br" && enc != "gzip" && enc != "deflate" {
+			return nil, fmt.Errorf("compression encoding %s not support", enc)
+		}
+	}
+	return c, nil
+}
+
+// CompressResponse 根据Accept-Encoding压缩发给客户端的响应
+func (c *Compressor) CompressResponse(ctx *core.RequestContext) error {
+	if ctx.Api != nil && ctx.Api.Meta.DisableCompression {
+		return nil
+	}
+	reqc := ctx.ReqCtx
+	resp := &reqc.Response
+	if reqc.IsHead() || resp.IsBodyStream() || !compressibleStatus(resp.StatusCode()) {
+		return nil
+	}
+	if len(resp.Header.Peek("Content-Encoding")) > 0 ||
+		bytes.Contains(resp.Header.Peek("Cache-Control"), []byte("no-transform")) {
+		return nil
+	}
+	body := resp.Body()
+	if len(body) < c.minSize || !c.allowType(resp.Header.ContentType()) {
+		return nil
+	}
+	core.AddVary(&resp.Header, "Accept-Encoding")
+	enc := negotiate(reqc.Request.Header.Peek("Accept-Encoding"), c.encodings)
+	var out []byte
+	switch enc {
+	case "br":
+		out = fasthttp.AppendBrotliBytes(nil, body)
+	case "gzip":
+		out = fasthttp.AppendGzipBytes(nil, body)
+	case "deflate":
+		out = fasthttp.AppendDeflateBytes(nil, body)
+	default:
+		return nil
+	}
+	if len(out) >= len(body) {
+		return nil
+	}
+	resp.SetBody(out)
+	resp.Header.Set("Content-Encoding", enc)
+	if etag := resp.Header.Peek("ETag"); len(etag) > 0 && !bytes.HasPrefix(etag, []byte("W/")) {
+		resp.Header.Set("ETag", "W/"+string(etag))
+	}
+	return nil
+}
+
+// CompressFile 压缩网关直接提供的静态文件，文件以流的方式写入响应，需要先读取到内存中
+func (c *Compressor) CompressFile(ctx *core.RequestContext) error {
+	reqc := ctx.ReqCtx
+	resp := &reqc.Response
+	if resp.IsBodyStream() {
+		size := resp.Header.ContentLength()
+		if reqc.IsHead() || !compressibleStatus(resp.StatusCode()) || size < c.minSize || size > maxFileSize ||
+			!c.allowType(resp.Header.ContentType()) || len(reqc.Request.Header.Peek("Accept-Encoding")) <= 0 {
+			return nil
+		}
+		resp.Body()
+	}
+	return c.CompressResponse(ctx)
+}
+
+// DecompressRequest 解压请求体，使后续的校验、取值等可以读取原始内容
+func (c *Compressor) DecompressRequest(ctx *core.RequestContext) error {
+	if ctx.Api != nil && ctx.Api.Meta.DisableCompression {
+		return nil
+	}
+	req := &ctx.ReqCtx.Request
+	enc := strings.ToLower(strings.TrimSpace(string(req.Header.Peek("Content-Encoding"))))
+	var r io.Reader
+	var err error
+	src := bytes.NewReader(req.Body())
+	switch enc {
+	case "gzip", "x-gzip":
+		r, err = gzip.NewReader(src)
+	case "deflate":
+		r, err = zlib.NewReader(src)
+	case "br":
+		r = brotli.NewReader(src)
+	default:
+		return nil
+	}
+	var body []byte
+	if err == nil {
+		body, err = ioutil.ReadAll(io.LimitReader(r, int64(c.maxSize)+1))
+	}
+	if err != nil {
+		ctx.WriteErrorWith(fasthttp.StatusBadRequest, core.ErrInvalidBody, "fail to decode "+enc+" body")
+		return core.ErrInvalidBody
+	}
+	if len(body) > c.maxSize {
+		ctx.WriteErrorWith(fasthttp.StatusRequestEntityTooLarge, core.ErrBodyTooLarge, "")
+		return core.ErrBodyTooLarge
+	}
+	req.SetBody(body)
+	req.Header.Del("Content-Encoding")
+	req.Header.SetContentLength(len(body))
+	return nil
+}
+
+func (c *Compressor) allowType(contentType []byte) bool {
+	ct := reflectx.BytesToString(contentType)
+	if idx := strings.IndexByte(ct, ';'); idx >= 0 {
+		ct = ct[:idx]
+	}
+	ct = strings.ToLower(strings.TrimSpace(ct))
+	for _, t := range c.types {
+		if strings.HasPrefix(ct, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func compressibleStatus(status int) bool {
+	return status >= 200 && status != fasthttp.StatusNoContent &&
+		status != fasthttp.StatusPartialContent && status != fasthttp.StatusNotModified
+}
+
+// negotiate 选择客户端权重最高的压缩算法，不支持时返回空
+func negotiate(accept []byte, supported []string) string {
+	if len(accept) <= 0 {
+		return ""
+	}
+	qs := make(map[string]float64)
+	for _, part := range strings.Split(reflectx.BytesToString(accept), ",") {
+		name, q := part, 1.0
+		if idx := strings.IndexByte(part, ';'); idx >= 0 {
+			name = part[:idx]
+			param := strings.TrimSpace(part[idx+1:])
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		qs[strings.ToLower(strings.TrimSpace(name))] = q
+	}
+	best, bestQ := "", 0.0
+	for _, enc := range supported {
+		q, ok := qs[enc]
+		if !ok {
+			if q, ok = qs["*"]; !ok {
+				continue
+			}
+		}
+		if q > bestQ {
+			best, bestQ = enc, q
+		}
+	}
+	return best
+}
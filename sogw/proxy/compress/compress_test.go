@@ -0,0 +1,114 @@
+package compress
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestNegotiate(t *testing.T) {
+	assert.Equal(t, "", negotiate(nil, DefaultEncodings))
+	assert.Equal(t, "gzip", negotiate([]byte("gzip, deflate"), DefaultEncodings))
+	assert.Equal(t, "br", negotiate([]byte("gzip, deflate, br"), DefaultEncodings))
+	assert.Equal(t, "gzip", negotiate([]byte("br;q=0.5, gzip;q=0.8"), DefaultEncodings))
+	assert.Equal(t, "gzip", negotiate([]byte("br;q=0, *"), DefaultEncodings))
+	assert.Equal(t, "", negotiate([]byte("identity"), DefaultEncodings))
+}
+
+func newTestContext() *core.RequestContext {
+	ctx := core.NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.Api = &core.Api{Meta: &meta.Api{Id: "api1"}}
+	return ctx
+}
+
+func TestCompressResponse(t *testing.T) {
+	c, err := New(&Config{Enable: true})
+	assert.Nil(t, err)
+	body := strings.Repeat("hello world ", 200)
+
+	ctx := newTestContext()
+	ctx.ReqCtx.Request.Header.Set("Accept-Encoding", "gzip")
+	resp := &ctx.ReqCtx.Response
+	resp.Header.SetContentType("application/json; charset=utf-8")
+	resp.Header.Set("ETag", `"abc"`)
+	resp.SetBodyString(body)
+	assert.Nil(t, c.CompressResponse(ctx))
+	assert.Equal(t, "gzip", string(resp.Header.Peek("Content-Encoding")))
+	assert.Equal(t, "Accept-Encoding", string(resp.Header.Peek("Vary")))
+	assert.Equal(t, `W/"abc"`, string(resp.Header.Peek("ETag")))
+	plain, err := resp.BodyGunzip()
+	assert.Nil(t, err)
+	assert.Equal(t, body, string(plain))
+
+	ctx = newTestContext()
+	ctx.ReqCtx.Request.Header.Set("Accept-Encoding", "gzip")
+	ctx.ReqCtx.Response.Header.SetContentType("image/png")
+	ctx.ReqCtx.Response.SetBodyString(body)
+	assert.Nil(t, c.CompressResponse(ctx))
+	assert.Equal(t, "", string(ctx.ReqCtx.Response.Header.Peek("Content-Encoding")))
+
+	ctx = newTestContext()
+	ctx.Api.Meta.DisableCompression = true
+	ctx.ReqCtx.Request.Header.Set("Accept-Encoding", "gzip")
+	ctx.ReqCtx.Response.Header.SetContentType("text/plain")
+	ctx.ReqCtx.Response.SetBodyString(body)
+	assert.Nil(t, c.CompressResponse(ctx))
+	assert.Equal(t, "", string(ctx.ReqCtx.Response.Header.Peek("Content-Encoding")))
+}
+
+func TestDecompressRequest(t *testing.T) {
+	c, err := New(&Config{DecompressRequest: true, MaxDecompressedSize: 100})
+	assert.Nil(t, err)
+
+	ctx := newTestContext()
+	ctx.ReqCtx.Request.Header.Set("Content-Encoding", "gzip")
+	ctx.ReqCtx.Request.SetBody(fasthttp.AppendGzipBytes(nil, []byte(`{"name":"sogw"}`)))
+	assert.Nil(t, c.DecompressRequest(ctx))
+	assert.Equal(t, `{"name":"sogw"}`, string(ctx.ReqCtx.Request.Body()))
+	assert.Equal(t, "", string(ctx.ReqCtx.Request.Header.Peek("Content-Encoding")))
+
+	ctx = newTestContext()
+	ctx.ReqCtx.Request.Header.Set("Content-Encoding", "br")
+	ctx.ReqCtx.Request.SetBody(fasthttp.AppendBrotliBytes(nil, []byte(strings.Repeat("a", 101))))
+	assert.Equal(t, core.ErrBodyTooLarge, c.DecompressRequest(ctx))
+	assert.Equal(t, fasthttp.StatusRequestEntityTooLarge, ctx.ReqCtx.Response.StatusCode())
+
+	ctx = newTestContext()
+	ctx.ReqCtx.Request.Header.Set("Content-Encoding", "gzip")
+	ctx.ReqCtx.Request.SetBodyString("not gzip")
+	assert.Equal(t, core.ErrInvalidBody, c.DecompressRequest(ctx))
+	assert.Equal(t, fasthttp.StatusBadRequest, ctx.ReqCtx.Response.StatusCode())
+}
+
+func TestCompressFile(t *testing.T) {
+	c, err := New(&Config{Enable: true})
+	assert.Nil(t, err)
+	root, err := ioutil.TempDir("", "static")
+	assert.Nil(t, err)
+	defer os.RemoveAll(root)
+	body := strings.Repeat("console.log(1);\n", 200)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(root, "main.js"), []byte(body), 0644))
+	s := core.NewStaticFiles(root, "/app/*")
+	defer core.SweepStaticFiles(nil)
+
+	reqc := &fasthttp.RequestCtx{}
+	reqc.Request.SetRequestURI("/app/main.js")
+	reqc.Request.Header.Set("Accept-Encoding", "gzip")
+	ctx := core.NewRequestContext(reqc)
+	s.Serve(ctx)
+	assert.Nil(t, c.CompressFile(ctx))
+	resp := &reqc.Response
+	assert.Equal(t, "gzip", string(resp.Header.Peek("Content-Encoding")))
+	plain, err := resp.BodyGunzip()
+	assert.Nil(t, err)
+	assert.Equal(t, body, string(plain))
+	files, _ := filepath.Glob(filepath.Join(root, "*.fasthttp.*"))
+	assert.Empty(t, files)
+}
@@ -1,10 +1,47 @@
 package proxy
 
+import (
+	"github.com/recallsong/sogw/sogw/proxy/accesslog"
+	"github.com/recallsong/sogw/sogw/proxy/cache"
+	"github.com/recallsong/sogw/sogw/proxy/compress"
+	"github.com/recallsong/sogw/sogw/proxy/tracing"
+)
+
 type Config struct {
 	// listen address
 	Addr     string `mapstructure:"addr"`
 	TLSAddr  string `mapstructure:"tls_addr"`
 	UnixAddr string `mapstructure:"unix_addr"`
+	// accept PROXY protocol v1/v2 header on tcp listeners
+	ProxyProtocol bool `mapstructure:"proxy_protocol"`
+	// only requests from these addresses can use X-Forwarded-For or Forwarded header,
+	// requests from the unix socket are always trusted
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
+	// header to read and propagate request id, default X-Request-Id
+	RequestIdHeader string `mapstructure:"request_id_header"`
+	// listen address for prometheus /metrics
+	MetricsAddr string `mapstructure:"metrics_addr"`
+
+	// access log
+	AccessLog accesslog.Config `mapstructure:"access_log"`
+	// distributed tracing
+	Tracing tracing.Config `mapstructure:"tracing"`
+	// response cache
+	Cache cache.Config `mapstructure:"cache"`
+	// response compression and request decompression
+	Compression compress.Config `mapstructure:"compression"`
+	// websocket proxying
+	WebSocket WebSocketConfig `mapstructure:"websocket"`
+	// streaming response passthrough, e.g. server-sent events
+	Stream StreamConfig `mapstructure:"stream"`
+	// request body size limit and streaming upload
+	RequestBody RequestBodyConfig `mapstructure:"request_body"`
+	// grpc and grpc-web over http/2 listeners
+	Grpc GrpcConfig `mapstructure:"grpc"`
+	// request timeout and deadline propagation
+	Timeout TimeoutConfig `mapstructure:"timeout"`
+	// traffic mirroring to secondary services
+	Mirror MirrorConfig `mapstructure:"mirror"`
 
 	// k/v store
 	Store StoreConfig `mapstructure:"store"`
@@ -22,6 +22,8 @@ type Api struct {
 	Validators Validators
 	URLRewrite []string
 	Server     *Server
+
+	deprecation *apiDeprecation
 }
 
 func NewApi(m *meta.Api, service *Service) *Api {
@@ -47,6 +49,8 @@ func NewApi(m *meta.Api, service *Service) *Api {
 		Validators: vs,
 		Context:    ValueContext(m.Context),
 		URLRewrite: makeURLRewrite(strings.TrimSpace(m.Path)),
+
+		deprecation: newApiDeprecation(m.Deprecation),
 	}
 }
 
@@ -128,11 +132,19 @@ func makeURLRewrite(path string) (parts []string) {
 	return
 }
 
-func (a *Api) DoAuth(ctx *RequestContext) error {
-	authId := ctx.Service.Config.AuthId
+// AuthId api配置的认证，未配置时使用服务的认证
+func (a *Api) AuthId(ctx *RequestContext) string {
 	if a.Meta.AuthId != "" {
-		authId = a.Meta.AuthId
+		return a.Meta.AuthId
+	}
+	if ctx.Service != nil && ctx.Service.Config != nil {
+		return ctx.Service.Config.AuthId
 	}
+	return ""
+}
+
+func (a *Api) DoAuth(ctx *RequestContext) error {
+	authId := a.AuthId(ctx)
 	if len(authId) <= 0 {
 		return nil
 	}
@@ -144,7 +156,7 @@ func (a *Api) DoAuth(ctx *RequestContext) error {
 	if cobrax.Flags.Debug {
 		log.Debug("[api] auth not found ", authId)
 	}
-	ctx.WriteError(http.StatusUnauthorized)
+	ctx.WriteErrorWith(http.StatusUnauthorized, ErrAuthFailed, "")
 	return ErrAuthFailed
 }
 
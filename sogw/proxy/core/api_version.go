@@ -0,0 +1,167 @@
+package core
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/recallsong/cliframe/cobrax"
+	"github.com/recallsong/go-utils/reflectx"
+	"github.com/recallsong/sogw/store/meta"
+	log "github.com/sirupsen/logrus"
+)
+
+const defaultVersionParam = "version"
+
+// ApiVersioning 从请求中获取客户端要求的api版本，同一个服务中method和path相同、version不同的api为同一个api的不同版本
+type ApiVersioning struct {
+	Meta *meta.ApiVersioning
+}
+
+func NewApiVersioning(m *meta.ApiVersioning) *ApiVersioning {
+	if m == nil {
+		return nil
+	}
+	return &ApiVersioning{Meta: m}
+}
+
+// Resolve 请求中没有版本时返回默认版本
+func (v *ApiVersioning) Resolve(ctx *RequestContext, api *Api) string {
+	var ver string
+	switch v.Meta.Source {
+	case meta.VersionSource_VersionPath:
+		ver = v.fromPath(ctx, api)
+	case meta.VersionSource_VersionAccept:
+		name := v.Meta.Name
+		if len(name) <= 0 {
+			name = defaultVersionParam
+		}
+		ver = mediaTypeParam(reflectx.BytesToString(ctx.ReqCtx.Request.Header.Peek("Accept")), name)
+	case meta.VersionSource_VersionHeader:
+		ver = string(ctx.ReqCtx.Request.Header.Peek(v.Meta.Name))
+	}
+	if len(ver) <= 0 {
+		return v.Meta.DefaultVersion
+	}
+	return ver
+}
+
+// fromPath 配置了name时从同名的路径参数中获取，否则使用路径的第一段，如 /v2/users 的版本为 v2，
+// 第一段不是api已有的版本时认为路径中没有版本，如 /users
+func (v *ApiVersioning) fromPath(ctx *RequestContext, api *Api) string {
+	if len(v.Meta.Name) > 0 {
+		for i, name := range ctx.PathNames {
+			if name == v.Meta.Name {
+				return ctx.PathValues[i]
+			}
+		}
+		return ""
+	}
+	path := strings.TrimPrefix(reflectx.BytesToString(ctx.ReqCtx.Path()), "/")
+	if idx := strings.IndexByte(path, '/'); idx >= 0 {
+		path = path[:idx]
+	}
+	if _, ok := ctx.Service.Versions[apiVersionKey(api.Meta)][path]; !ok {
+		return ""
+	}
+	return path
+}
+
+// mediaTypeParam 获取Accept中第一个带有该参数的媒体类型的参数值，如 application/json; version=2
+func mediaTypeParam(accept, name string) string {
+	for _, mt := range strings.Split(accept, ",") {
+		params := strings.Split(mt, ";")
+		for _, p := range params[1:] {
+			kv := strings.SplitN(p, "=", 2)
+			if len(kv) == 2 && strings.EqualFold(strings.TrimSpace(kv[0]), name) {
+				return strings.Trim(strings.TrimSpace(kv[1]), `"`)
+			}
+		}
+	}
+	return ""
+}
+
+func apiVersionKey(m *meta.Api) string {
+	return m.Method + ":" + m.Path
+}
+
+// IndexVersions 按照method和path索引服务中api的各个版本，在添加api之后调用
+func (s *Service) IndexVersions() {
+	s.Versions = make(map[string]map[string]*Api)
+	for _, a := range s.Apis {
+		key := apiVersionKey(a.Meta)
+		vers := s.Versions[key]
+		if vers == nil {
+			vers = make(map[string]*Api)
+			s.Versions[key] = vers
+		}
+		vers[a.Meta.Version] = a
+	}
+}
+
+// ApiVersion 获取api的指定版本，不存在时返回nil
+func (s *Service) ApiVersion(a *Api, version string) *Api {
+	if a.Meta.Version == version {
+		return a
+	}
+	return s.Versions[apiVersionKey(a.Meta)][version]
+}
+
+// selectVersion 按照客户端要求的版本替换已选择的api
+func (r *Route) selectVersion(ctx *RequestContext, api *Api) *Api {
+	ver := r.Versions.Resolve(ctx, api)
+	if len(ver) <= 0 {
+		return api
+	}
+	v := ctx.Service.ApiVersion(api, ver)
+	if v == nil || v.Meta.Status == meta.Status_Close {
+		if cobrax.Flags.Debug {
+			log.Debugf("[route] version %s of api (id=%s) not found or closed", ver, api.Meta.Id)
+		}
+		ctx.Api = nil
+		return nil
+	}
+	ctx.Api = v
+	return v
+}
+
+// apiDeprecation 已废弃api的响应头，参考 RFC 9745 和 RFC 8594
+type apiDeprecation struct {
+	deprecation string
+	sunset      string
+	link        string
+}
+
+func newApiDeprecation(m *meta.ApiDeprecation) *apiDeprecation {
+	if m == nil {
+		return nil
+	}
+	d := &apiDeprecation{deprecation: "true"}
+	if t, err := time.Parse(time.RFC3339, m.Date); err == nil {
+		d.deprecation = "@" + strconv.FormatInt(t.Unix(), 10)
+	}
+	if t, err := time.Parse(time.RFC3339, m.Sunset); err == nil {
+		d.sunset = t.UTC().Format(http.TimeFormat)
+	}
+	if len(m.Link) > 0 {
+		d.link = "<" + m.Link + `>; rel="deprecation"`
+	}
+	return d
+}
+
+// SetDeprecationHeader api已废弃时添加 Deprecation、Sunset 和 Link 响应头
+func (a *Api) SetDeprecationHeader(ctx *RequestContext) {
+	d := a.deprecation
+	if d == nil {
+		return
+	}
+	h := &ctx.ReqCtx.Response.Header
+	h.Set("Deprecation", d.deprecation)
+	if len(d.sunset) > 0 {
+		h.Set("Sunset", d.sunset)
+	}
+	if len(d.link) > 0 {
+		h.Add("Link", d.link)
+	}
+}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestRouteVersioning(t *testing.T) {
+	ser := NewService(&meta.Service{Id: "s1", Name: "s1"})
+	ser.Init(nil)
+	ser.Apis["a1"] = NewApi(&meta.Api{Id: "a1", Path: "/users", Version: "v1", Deprecation: &meta.ApiDeprecation{
+		Date: "2023-06-30T00:00:00Z", Sunset: "2024-01-01T00:00:00Z", Link: "https://example.com/v2",
+	}}, ser)
+	ser.Apis["a2"] = NewApi(&meta.Api{Id: "a2", Path: "/users", Version: "v2"}, ser)
+	ser.Apis["a3"] = NewApi(&meta.Api{Id: "a3", Path: "/users", Version: "v3", Status: meta.Status_Close}, ser)
+	ser.IndexVersions()
+	newCtx := func(path, header, accept string) *RequestContext {
+		ctx := NewRequestContext(&fasthttp.RequestCtx{})
+		ctx.Services = map[string]*Service{"s1": ser}
+		ctx.ReqCtx.Request.SetRequestURI(path)
+		if len(header) > 0 {
+			ctx.ReqCtx.Request.Header.Set("X-Api-Version", header)
+		}
+		if len(accept) > 0 {
+			ctx.ReqCtx.Request.Header.Set("Accept", accept)
+		}
+		return ctx
+	}
+
+	route := NewRoute(&meta.Route{Id: "r1", Service: "s1", ApiId: "a1", Versioning: &meta.ApiVersioning{
+		Source: meta.VersionSource_VersionHeader, Name: "X-Api-Version", DefaultVersion: "v2",
+	}})
+	assert.Equal(t, "a2", route.Dispatch(newCtx("/users", "", "")).Meta.Id)
+	assert.Equal(t, "a1", route.Dispatch(newCtx("/users", "v1", "")).Meta.Id)
+	assert.Nil(t, route.Dispatch(newCtx("/users", "v3", "")))
+	assert.Nil(t, route.Dispatch(newCtx("/users", "v4", "")))
+
+	route = NewRoute(&meta.Route{Id: "r1", Service: "s1", ApiId: "a1", Versioning: &meta.ApiVersioning{
+		Source: meta.VersionSource_VersionAccept,
+	}})
+	assert.Equal(t, "a2", route.Dispatch(newCtx("/users", "", `text/html, application/json; q=0.9; version="v2"`)).Meta.Id)
+	assert.Equal(t, "a1", route.Dispatch(newCtx("/users", "", "application/json")).Meta.Id)
+
+	route = NewRoute(&meta.Route{Id: "r1", Service: "s1", ApiId: "a1", Versioning: &meta.ApiVersioning{}})
+	ctx := newCtx("/v2/users", "", "")
+	assert.Equal(t, "a2", route.Dispatch(ctx).Meta.Id)
+	assert.Equal(t, "a2", ctx.Api.Meta.Id)
+
+	route = NewRoute(&meta.Route{Id: "r1", Service: "s1", ApiId: "a1", Versioning: &meta.ApiVersioning{DefaultVersion: "v2"}})
+	assert.Equal(t, "a2", route.Dispatch(newCtx("/users", "", "")).Meta.Id)
+	assert.Equal(t, "a1", route.Dispatch(newCtx("/v1/users", "", "")).Meta.Id)
+
+	ctx = newCtx("/v1/users", "", "")
+	route.Dispatch(ctx).SetDeprecationHeader(ctx)
+	h := &ctx.ReqCtx.Response.Header
+	assert.Equal(t, "@1688083200", string(h.Peek("Deprecation")))
+	assert.Equal(t, "Mon, 01 Jan 2024 00:00:00 GMT", string(h.Peek("Sunset")))
+	assert.Equal(t, `<https://example.com/v2>; rel="deprecation"`, string(h.Peek("Link")))
+}
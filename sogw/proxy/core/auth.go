@@ -52,6 +52,7 @@ func doHttpBasic(cfg map[string]string, ctx *RequestContext) bool {
 			passwd = auth[idx+1:]
 		}
 		if pwd, ok := cfg[user]; ok && pwd == passwd {
+			ctx.Identity = user
 			return true
 		}
 	}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"net"
+	"strings"
+
+	"github.com/recallsong/go-utils/reflectx"
+	"github.com/valyala/fasthttp"
+)
+
+// TrustedProxies 可信代理的地址列表，只有来自可信代理的请求才会解析X-Forwarded-For和Forwarded头
+type TrustedProxies []*net.IPNet
+
+func NewTrustedProxies(cidrs []string) (TrustedProxies, error) {
+	var tps TrustedProxies
+	for _, cidr := range cidrs {
+		n, err := ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, err
+		}
+		tps = append(tps, n)
+	}
+	return tps, nil
+}
+
+func (tps TrustedProxies) Contains(ip net.IP) bool {
+	for _, n := range tps {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveClientIP 从右向左遍历转发链，返回第一个不可信的地址
+func (tps TrustedProxies) ResolveClientIP(remote net.IP, chain []string) net.IP {
+	if !tps.Contains(remote) {
+		return remote
+	}
+	return tps.resolveChain(remote, chain)
+}
+
+func (tps TrustedProxies) resolveChain(remote net.IP, chain []string) net.IP {
+	client := remote
+	for i := len(chain) - 1; i >= 0; i-- {
+		ip := parseForwardedIP(chain[i])
+		if ip == nil {
+			break
+		}
+		client = ip
+		if !tps.Contains(ip) {
+			break
+		}
+	}
+	return client
+}
+
+// forwardedChain 优先使用RFC 7239的Forwarded头，其次是X-Forwarded-For
+func forwardedChain(h *fasthttp.RequestHeader) []string {
+	var fwd, xff []string
+	h.VisitAll(func(key, value []byte) {
+		switch strings.ToLower(reflectx.BytesToString(key)) {
+		case "forwarded":
+			fwd = append(fwd, parseForwardedFor(string(value))...)
+		case "x-forwarded-for":
+			for _, item := range strings.Split(string(value), ",") {
+				if item = strings.TrimSpace(item); len(item) > 0 {
+					xff = append(xff, item)
+				}
+			}
+		}
+	})
+	if len(fwd) > 0 {
+		return fwd
+	}
+	return xff
+}
+
+// parseForwardedFor 解析Forwarded头中的for参数，例如：for=192.0.2.60;proto=http, for="[2001:db8::1]:4711"
+func parseForwardedFor(value string) []string {
+	var list []string
+	for _, elem := range strings.Split(value, ",") {
+		var addr string
+		for _, pair := range strings.Split(elem, ";") {
+			kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+			if len(kv) == 2 && strings.EqualFold(kv[0], "for") {
+				addr = strings.Trim(strings.TrimSpace(kv[1]), `"`)
+			}
+		}
+		list = append(list, addr)
+	}
+	return list
+}
+
+// parseForwardedIP 解析转发链中的地址，支持 ip、ip:port、[ipv6]、[ipv6]:port 格式
+func parseForwardedIP(addr string) net.IP {
+	addr = strings.TrimSpace(addr)
+	if ip := net.ParseIP(addr); ip != nil {
+		return ip
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return net.ParseIP(host)
+	}
+	if strings.HasPrefix(addr, "[") && strings.HasSuffix(addr, "]") {
+		return net.ParseIP(addr[1 : len(addr)-1])
+	}
+	return nil
+}
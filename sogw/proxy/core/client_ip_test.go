@@ -0,0 +1,56 @@
+package core
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestResolveClientIP(t *testing.T) {
+	tps, err := NewTrustedProxies([]string{"10.0.0.0/8", "::1"})
+	assert.Nil(t, err)
+
+	remote := net.ParseIP("10.0.0.2")
+	assert.Equal(t, "1.1.1.1", tps.ResolveClientIP(remote, []string{"1.1.1.1"}).String())
+	assert.Equal(t, "2.2.2.2", tps.ResolveClientIP(remote, []string{"1.1.1.1", "2.2.2.2", "10.0.0.1"}).String())
+	assert.Equal(t, "10.0.0.3", tps.ResolveClientIP(remote, []string{"10.0.0.3"}).String())
+	assert.Equal(t, "10.0.0.1", tps.ResolveClientIP(remote, []string{"1.1.1.1", "bad", "10.0.0.1"}).String())
+	assert.Equal(t, "2001:db8::1", tps.ResolveClientIP(net.ParseIP("::1"), []string{"[2001:db8::1]:4711"}).String())
+
+	remote = net.ParseIP("3.3.3.3")
+	assert.Equal(t, "3.3.3.3", tps.ResolveClientIP(remote, []string{"1.1.1.1"}).String())
+
+	_, err = NewTrustedProxies([]string{"abc"})
+	assert.NotNil(t, err)
+}
+
+func TestForwardedChain(t *testing.T) {
+	var h fasthttp.RequestHeader
+	h.Add("X-Forwarded-For", "1.1.1.1, 2.2.2.2")
+	h.Add("X-Forwarded-For", "3.3.3.3")
+	assert.Equal(t, []string{"1.1.1.1", "2.2.2.2", "3.3.3.3"}, forwardedChain(&h))
+
+	h.Set("Forwarded", `for=192.0.2.60;proto=http;by=203.0.113.43, For="[2001:db8:cafe::17]:4711"`)
+	assert.Equal(t, []string{"192.0.2.60", "[2001:db8:cafe::17]:4711"}, forwardedChain(&h))
+}
+
+func TestRealClientIPUnixSocket(t *testing.T) {
+	newContext := func(addr net.Addr) *RequestContext {
+		req := &fasthttp.Request{}
+		req.Header.Set("X-Forwarded-For", "1.1.1.1, 2.2.2.2")
+		reqc := &fasthttp.RequestCtx{}
+		reqc.Init(req, addr, nil)
+		return NewRequestContext(reqc)
+	}
+	ctx := newContext(&net.UnixAddr{Name: "/tmp/sogw.sock", Net: "unix"})
+	assert.Equal(t, "2.2.2.2", ctx.GetRealClientAddr())
+
+	ctx = newContext(&net.UnixAddr{Name: "/tmp/sogw.sock", Net: "unix"})
+	ctx.TrustedProxies, _ = NewTrustedProxies([]string{"2.2.2.2"})
+	assert.Equal(t, "1.1.1.1", ctx.GetRealClientAddr())
+
+	ctx = newContext(&net.TCPAddr{IP: net.ParseIP("3.3.3.3"), Port: 80})
+	assert.Equal(t, "3.3.3.3", ctx.GetRealClientAddr())
+}
@@ -0,0 +1,167 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/recallsong/go-utils/reflectx"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/valyala/fasthttp"
+)
+
+var defaultCorsMethods = []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE"}
+
+// CorsPolicy 按照 api > service > host 的优先级查找跨域配置
+func (c *RequestContext) CorsPolicy() *meta.CorsPolicy {
+	if c.Api != nil && c.Api.Meta.Cors != nil {
+		return c.Api.Meta.Cors
+	}
+	if c.Service != nil && c.Service.Config != nil && c.Service.Config.Cors != nil {
+		return c.Service.Config.Cors
+	}
+	if c.Host != nil && c.Host.Meta.Cors != nil {
+		return c.Host.Meta.Cors
+	}
+	return nil
+}
+
+// IsPreflight 是否是跨域预检请求
+func IsPreflight(reqc *fasthttp.RequestCtx) bool {
+	h := &reqc.Request.Header
+	return reqc.IsOptions() && len(h.Peek("Origin")) > 0 && len(h.Peek("Access-Control-Request-Method")) > 0
+}
+
+// WritePreflight 根据跨域配置响应预检请求，不允许时返回403
+func (c *RequestContext) WritePreflight(p *meta.CorsPolicy) error {
+	reqh := &c.ReqCtx.Request.Header
+	resp := &c.ReqCtx.Response
+	origin := string(reqh.Peek("Origin"))
+	method := string(reqh.Peek("Access-Control-Request-Method"))
+	headers := string(reqh.Peek("Access-Control-Request-Headers"))
+	if !corsAllowOrigin(p, origin) {
+		c.WriteErrorWith(fasthttp.StatusForbidden, ErrCorsNotAllow, "origin "+origin+" not allowed")
+		return ErrCorsNotAllow
+	}
+	methods := p.AllowMethods
+	if len(methods) <= 0 {
+		methods = defaultCorsMethods
+	}
+	if !containsFold(methods, method) {
+		c.WriteErrorWith(fasthttp.StatusForbidden, ErrCorsNotAllow, "method "+method+" not allowed")
+		return ErrCorsNotAllow
+	}
+	allowHeaders := headers
+	if len(p.AllowHeaders) > 0 && !containsFold(p.AllowHeaders, "*") {
+		for _, h := range strings.Split(headers, ",") {
+			h = strings.TrimSpace(h)
+			if len(h) > 0 && !containsFold(p.AllowHeaders, h) {
+				c.WriteErrorWith(fasthttp.StatusForbidden, ErrCorsNotAllow, "header "+h+" not allowed")
+				return ErrCorsNotAllow
+			}
+		}
+		allowHeaders = strings.Join(p.AllowHeaders, ", ")
+	}
+	resp.Reset()
+	resp.SetStatusCode(fasthttp.StatusNoContent)
+	setCorsOrigin(p, &resp.Header, origin)
+	resp.Header.Set("Access-Control-Allow-Methods", strings.Join(methods, ", "))
+	if len(allowHeaders) > 0 {
+		resp.Header.Set("Access-Control-Allow-Headers", allowHeaders)
+	}
+	if p.MaxAge > 0 {
+		resp.Header.Set("Access-Control-Max-Age", strconv.FormatInt(p.MaxAge, 10))
+	}
+	AddVary(&resp.Header, "Access-Control-Request-Method")
+	AddVary(&resp.Header, "Access-Control-Request-Headers")
+	return nil
+}
+
+// SetCorsHeaders 为跨域的实际请求设置响应头，服务器已经设置过时不覆盖
+func (c *RequestContext) SetCorsHeaders() {
+	origin := reflectx.BytesToString(c.ReqCtx.Request.Header.Peek("Origin"))
+	if len(origin) <= 0 {
+		return
+	}
+	resp := &c.ReqCtx.Response
+	if len(resp.Header.Peek("Access-Control-Allow-Origin")) > 0 {
+		return
+	}
+	p := c.CorsPolicy()
+	if p == nil || !corsAllowOrigin(p, origin) {
+		return
+	}
+	setCorsOrigin(p, &resp.Header, origin)
+	if len(p.ExposeHeaders) > 0 {
+		resp.Header.Set("Access-Control-Expose-Headers", strings.Join(p.ExposeHeaders, ", "))
+	}
+}
+
+func setCorsOrigin(p *meta.CorsPolicy, h *fasthttp.ResponseHeader, origin string) {
+	if !p.AllowCredentials && len(p.AllowOrigins) == 1 && p.AllowOrigins[0] == "*" {
+		h.Set("Access-Control-Allow-Origin", "*")
+		return
+	}
+	h.Set("Access-Control-Allow-Origin", origin)
+	if p.AllowCredentials {
+		h.Set("Access-Control-Allow-Credentials", "true")
+	}
+	AddVary(h, "Origin")
+}
+
+func corsAllowOrigin(p *meta.CorsPolicy, origin string) bool {
+	for _, pattern := range p.AllowOrigins {
+		if matchOrigin(pattern, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchOrigin 匹配来源，pattern中的*匹配任意字符，例如 https://*.example.com
+func matchOrigin(pattern, origin string) bool {
+	if pattern == "*" {
+		return true
+	}
+	parts := strings.Split(strings.ToLower(pattern), "*")
+	origin = strings.ToLower(origin)
+	if len(parts) == 1 {
+		return parts[0] == origin
+	}
+	if !strings.HasPrefix(origin, parts[0]) {
+		return false
+	}
+	origin = origin[len(parts[0]):]
+	last := len(parts) - 1
+	for _, part := range parts[1:last] {
+		idx := strings.Index(origin, part)
+		if idx < 0 {
+			return false
+		}
+		origin = origin[idx+len(part):]
+	}
+	return strings.HasSuffix(origin, parts[last])
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddVary 向Vary响应头中添加name，已经存在时忽略
+func AddVary(h *fasthttp.ResponseHeader, name string) {
+	vary := string(h.Peek("Vary"))
+	for _, v := range strings.Split(vary, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" || strings.EqualFold(v, name) {
+			return
+		}
+	}
+	if len(vary) > 0 {
+		vary += ", "
+	}
+	h.Set("Vary", vary+name)
+}
@@ -0,0 +1,79 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestMatchOrigin(t *testing.T) {
+	assert.True(t, matchOrigin("*", "https://a.com"))
+	assert.True(t, matchOrigin("https://a.com", "https://A.com"))
+	assert.False(t, matchOrigin("https://a.com", "https://a.com.cn"))
+	assert.True(t, matchOrigin("https://*.example.com", "https://api.example.com"))
+	assert.False(t, matchOrigin("https://*.example.com", "https://example.com"))
+	assert.False(t, matchOrigin("https://*.example.com", "http://api.example.com"))
+	assert.True(t, matchOrigin("http://localhost:*", "http://localhost:3000"))
+}
+
+func newPreflightContext(origin, method, headers string) *RequestContext {
+	reqc := &fasthttp.RequestCtx{}
+	reqc.Request.Header.SetMethod("OPTIONS")
+	reqc.Request.Header.Set("Origin", origin)
+	reqc.Request.Header.Set("Access-Control-Request-Method", method)
+	if len(headers) > 0 {
+		reqc.Request.Header.Set("Access-Control-Request-Headers", headers)
+	}
+	return NewRequestContext(reqc)
+}
+
+func TestWritePreflight(t *testing.T) {
+	policy := &meta.CorsPolicy{
+		AllowOrigins:     []string{"https://*.example.com"},
+		AllowMethods:     []string{"GET", "POST"},
+		AllowHeaders:     []string{"Content-Type", "Authorization"},
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	ctx := newPreflightContext("https://app.example.com", "POST", "content-type")
+	assert.True(t, IsPreflight(ctx.ReqCtx))
+	assert.Nil(t, ctx.WritePreflight(policy))
+	h := &ctx.ReqCtx.Response.Header
+	assert.Equal(t, fasthttp.StatusNoContent, ctx.ReqCtx.Response.StatusCode())
+	assert.Equal(t, "https://app.example.com", string(h.Peek("Access-Control-Allow-Origin")))
+	assert.Equal(t, "true", string(h.Peek("Access-Control-Allow-Credentials")))
+	assert.Equal(t, "GET, POST", string(h.Peek("Access-Control-Allow-Methods")))
+	assert.Equal(t, "Content-Type, Authorization", string(h.Peek("Access-Control-Allow-Headers")))
+	assert.Equal(t, "600", string(h.Peek("Access-Control-Max-Age")))
+	assert.Equal(t, "Origin, Access-Control-Request-Method, Access-Control-Request-Headers", string(h.Peek("Vary")))
+
+	ctx = newPreflightContext("https://evil.com", "POST", "")
+	assert.Equal(t, ErrCorsNotAllow, ctx.WritePreflight(policy))
+	assert.Equal(t, fasthttp.StatusForbidden, ctx.ReqCtx.Response.StatusCode())
+
+	ctx = newPreflightContext("https://app.example.com", "DELETE", "")
+	assert.Equal(t, ErrCorsNotAllow, ctx.WritePreflight(policy))
+
+	ctx = newPreflightContext("https://app.example.com", "GET", "X-Custom")
+	assert.Equal(t, ErrCorsNotAllow, ctx.WritePreflight(policy))
+}
+
+func TestSetCorsHeaders(t *testing.T) {
+	ctx := NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.ReqCtx.Request.Header.Set("Origin", "https://a.com")
+	ctx.Host = NewHost(&meta.Host{Id: "h", Value: "*", Cors: &meta.CorsPolicy{
+		AllowOrigins:  []string{"*"},
+		ExposeHeaders: []string{"X-Total"},
+	}})
+	ctx.SetCorsHeaders()
+	h := &ctx.ReqCtx.Response.Header
+	assert.Equal(t, "*", string(h.Peek("Access-Control-Allow-Origin")))
+	assert.Equal(t, "X-Total", string(h.Peek("Access-Control-Expose-Headers")))
+
+	ctx.Api = &Api{Meta: &meta.Api{Id: "a", Cors: &meta.CorsPolicy{AllowOrigins: []string{"https://b.com"}}}}
+	ctx.ReqCtx.Response.Reset()
+	ctx.SetCorsHeaders()
+	assert.Equal(t, "", string(ctx.ReqCtx.Response.Header.Peek("Access-Control-Allow-Origin")))
+}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/recallsong/sogw/store/meta"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Descriptors 按名称保存已解析的proto描述，用于将REST请求转换为gRPC调用
+type Descriptors struct {
+	files map[string]*protoregistry.Files
+}
+
+func NewDescriptors(descs map[string]*meta.ProtoDescriptor) *Descriptors {
+	d := &Descriptors{files: make(map[string]*protoregistry.Files)}
+	for _, item := range descs {
+		files, err := ParseDescriptorSet(item.Data)
+		if err != nil {
+			log.Errorf("[descriptor] invalid proto descriptor %s : %s", item.Name, err)
+			continue
+		}
+		d.files[item.Name] = files
+	}
+	return d
+}
+
+// ParseDescriptorSet 解析 protoc --descriptor_set_out 生成的FileDescriptorSet，需要包含所有依赖
+func ParseDescriptorSet(data []byte) (*protoregistry.Files, error) {
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, set); err != nil {
+		return nil, err
+	}
+	return protodesc.NewFiles(set)
+}
+
+// FindMethod 查找gRPC方法，method格式为 package.Service/Method 或者 package.Service.Method
+func (d *Descriptors) FindMethod(name, method string) (protoreflect.MethodDescriptor, error) {
+	if d == nil {
+		return nil, fmt.Errorf("proto descriptor %s not found", name)
+	}
+	files, ok := d.files[name]
+	if !ok {
+		return nil, fmt.Errorf("proto descriptor %s not found", name)
+	}
+	fullName := strings.Replace(strings.TrimPrefix(method, "/"), "/", ".", 1)
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(fullName))
+	if err != nil {
+		return nil, fmt.Errorf("grpc method %s not found in %s", method, name)
+	}
+	md, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s in %s is not a grpc method", method, name)
+	}
+	return md, nil
+}
@@ -0,0 +1,179 @@
+package core
+
+import (
+	"encoding/json"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/valyala/fasthttp"
+)
+
+// errorCodes 机器可读的错误码
+var errorCodes = map[error]string{
+	ErrRouteNotFound:      "route_not_found",
+	ErrMethodNotAllow:     "method_not_allowed",
+	ErrHostNotAllow:       "host_not_allowed",
+	ErrAuthFailed:         "auth_failed",
+	ErrApiValidateFailed:  "validation_failed",
+	ErrServiceUnavailable: "backend_unavailable",
+	ErrIPNotAllow:         "ip_not_allowed",
+	ErrInvalidBody:        "invalid_body",
+	ErrBodyTooLarge:       "body_too_large",
+	ErrCorsNotAllow:       "cors_not_allowed",
+	ErrUnsupportedMedia:   "unsupported_media_type",
+	ErrNotImplemented:     "not_implemented",
+	ErrGatewayTimeout:     "gateway_timeout",
+}
+
+var statusErrorCodes = map[int]string{
+	fasthttp.StatusBadRequest:            "bad_request",
+	fasthttp.StatusUnauthorized:          "unauthorized",
+	fasthttp.StatusForbidden:             "forbidden",
+	fasthttp.StatusNotFound:              "not_found",
+	fasthttp.StatusMethodNotAllowed:      "method_not_allowed",
+	fasthttp.StatusRequestEntityTooLarge: "body_too_large",
+	fasthttp.StatusInternalServerError:   "internal_error",
+	fasthttp.StatusBadGateway:            "bad_gateway",
+	fasthttp.StatusServiceUnavailable:    "service_unavailable",
+	fasthttp.StatusGatewayTimeout:        "gateway_timeout",
+}
+
+// ErrorCode 获取错误对应的错误码，未知的错误根据状态码获取
+func ErrorCode(err error, statusCode int) string {
+	if code, ok := errorCodes[err]; ok {
+		return code
+	}
+	if code, ok := statusErrorCodes[statusCode]; ok {
+		return code
+	}
+	return "error"
+}
+
+// ErrorPages 按照 api > service > host 的优先级查找错误页配置
+func (c *RequestContext) ErrorPages() *meta.ErrorPages {
+	if c.Api != nil && c.Api.Meta.ErrorPages != nil {
+		return c.Api.Meta.ErrorPages
+	}
+	if c.Service != nil && c.Service.Config != nil && c.Service.Config.ErrorPages != nil {
+		return c.Service.Config.ErrorPages
+	}
+	if c.Host != nil && c.Host.Meta.ErrorPages != nil {
+		return c.Host.Meta.ErrorPages
+	}
+	return nil
+}
+
+type errorInfo struct {
+	Type      string `json:"type"`
+	Title     string `json:"title"`
+	Status    int    `json:"status"`
+	Code      string `json:"code"`
+	Detail    string `json:"detail,omitempty"`
+	RequestId string `json:"request_id,omitempty"`
+}
+
+func (c *RequestContext) WriteError(statusCode int) {
+	c.WriteErrorWith(statusCode, nil, "")
+}
+
+// WriteErrorWith 根据错误页配置输出错误响应，detail为错误的详细描述
+func (c *RequestContext) WriteErrorWith(statusCode int, err error, detail string) {
+	info := &errorInfo{
+		Type:      "about:blank",
+		Title:     fasthttp.StatusMessage(statusCode),
+		Status:    statusCode,
+		Code:      ErrorCode(err, statusCode),
+		Detail:    detail,
+		RequestId: c.RequestId,
+	}
+	format := meta.ErrorFormat_Text
+	pages := c.ErrorPages()
+	if pages != nil {
+		format = pages.Format
+	}
+	resp := &c.ReqCtx.Response
+	resp.Reset()
+	resp.SetStatusCode(statusCode)
+	switch format {
+	case meta.ErrorFormat_JSON:
+		resp.Header.SetContentType("application/problem+json")
+	case meta.ErrorFormat_HTML:
+		resp.Header.SetContentType("text/html; charset=utf-8")
+	default:
+		resp.Header.SetContentType("text/plain; charset=utf-8")
+	}
+	if tpl, ok := lookupErrorBody(pages, statusCode); ok {
+		resp.SetBodyString(renderErrorBody(tpl, format, info))
+		return
+	}
+	switch format {
+	case meta.ErrorFormat_JSON:
+		body, _ := json.Marshal(info)
+		resp.SetBody(body)
+	case meta.ErrorFormat_HTML:
+		resp.SetBodyString(renderErrorBody(defaultHTMLErrorBody, format, info))
+	default:
+		body := strconv.Itoa(statusCode) + " " + info.Title
+		if len(detail) > 0 {
+			body = detail
+		}
+		if len(info.RequestId) > 0 {
+			body += ", request id: " + info.RequestId
+		}
+		resp.SetBodyString(body)
+	}
+}
+
+const defaultHTMLErrorBody = `<html>
+<head><title>{{status}} {{title}}</title></head>
+<body>
+<h1>{{status}} {{title}}</h1>
+<p>{{detail}}</p>
+<hr><p>request id: {{request_id}}</p>
+</body>
+</html>`
+
+// lookupErrorBody 按照 状态码 > 状态码分类 > * 的顺序查找自定义的错误页
+func lookupErrorBody(pages *meta.ErrorPages, statusCode int) (string, bool) {
+	if pages == nil || len(pages.Bodies) <= 0 {
+		return "", false
+	}
+	status := strconv.Itoa(statusCode)
+	if body, ok := pages.Bodies[status]; ok {
+		return body, true
+	}
+	if len(status) == 3 {
+		if body, ok := pages.Bodies[status[:1]+"xx"]; ok {
+			return body, true
+		}
+	}
+	body, ok := pages.Bodies["*"]
+	return body, ok
+}
+
+// renderErrorBody 替换模版中的 {{status}}、{{title}}、{{code}}、{{detail}}、{{request_id}}，值根据格式进行转义
+func renderErrorBody(tpl string, format meta.ErrorFormat, info *errorInfo) string {
+	escape := func(s string) string { return s }
+	switch format {
+	case meta.ErrorFormat_JSON:
+		escape = func(s string) string {
+			b, _ := json.Marshal(s)
+			return string(b[1 : len(b)-1])
+		}
+	case meta.ErrorFormat_HTML:
+		escape = html.EscapeString
+	}
+	detail := info.Detail
+	if len(detail) <= 0 {
+		detail = info.Title
+	}
+	return strings.NewReplacer(
+		"{{status}}", strconv.Itoa(info.Status),
+		"{{title}}", escape(info.Title),
+		"{{code}}", escape(info.Code),
+		"{{detail}}", escape(detail),
+		"{{request_id}}", escape(info.RequestId),
+	).Replace(tpl)
+}
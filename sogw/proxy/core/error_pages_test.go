@@ -0,0 +1,56 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestWriteErrorJSON(t *testing.T) {
+	ctx := NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.RequestId = "rid"
+	ctx.Host = NewHost(&meta.Host{Id: "h", Value: "*", ErrorPages: &meta.ErrorPages{Format: meta.ErrorFormat_JSON}})
+	ctx.WriteErrorWith(fasthttp.StatusNotFound, ErrRouteNotFound, "")
+	resp := &ctx.ReqCtx.Response
+	assert.Equal(t, 404, resp.StatusCode())
+	assert.Equal(t, "application/problem+json", string(resp.Header.ContentType()))
+	var body map[string]interface{}
+	assert.Nil(t, json.Unmarshal(resp.Body(), &body))
+	assert.Equal(t, "route_not_found", body["code"])
+	assert.Equal(t, "Not Found", body["title"])
+	assert.Equal(t, "rid", body["request_id"])
+}
+
+func TestWriteErrorTemplate(t *testing.T) {
+	ctx := NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.RequestId = "rid"
+	ctx.Host = NewHost(&meta.Host{Id: "h", Value: "*", ErrorPages: &meta.ErrorPages{Format: meta.ErrorFormat_JSON}})
+	ctx.Api = &Api{Meta: &meta.Api{Id: "a", ErrorPages: &meta.ErrorPages{
+		Format: meta.ErrorFormat_HTML,
+		Bodies: map[string]string{
+			"400": "<p>{{code}}: {{detail}}</p>",
+			"5xx": "<p>server error {{status}}</p>",
+		},
+	}}}
+	ctx.WriteErrorWith(fasthttp.StatusBadRequest, ErrApiValidateFailed, "<name> required")
+	assert.Equal(t, "<p>validation_failed: &lt;name&gt; required</p>", string(ctx.ReqCtx.Response.Body()))
+	assert.Equal(t, "text/html; charset=utf-8", string(ctx.ReqCtx.Response.Header.ContentType()))
+
+	ctx.WriteErrorWith(fasthttp.StatusServiceUnavailable, ErrServiceUnavailable, "")
+	assert.Equal(t, "<p>server error 503</p>", string(ctx.ReqCtx.Response.Body()))
+
+	ctx.WriteErrorWith(fasthttp.StatusUnauthorized, ErrAuthFailed, "")
+	assert.Contains(t, string(ctx.ReqCtx.Response.Body()), "<h1>401 Unauthorized</h1>")
+}
+
+func TestWriteErrorText(t *testing.T) {
+	ctx := NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.WriteErrorWith(fasthttp.StatusBadRequest, ErrApiValidateFailed, "name required")
+	assert.Equal(t, "name required", string(ctx.ReqCtx.Response.Body()))
+	ctx.WriteError(fasthttp.StatusBadGateway)
+	assert.Equal(t, "502 Bad Gateway", string(ctx.ReqCtx.Response.Body()))
+	assert.Equal(t, "bad_gateway", ErrorCode(nil, fasthttp.StatusBadGateway))
+}
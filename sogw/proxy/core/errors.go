@@ -1,6 +1,12 @@
 package core
 
-import "errors"
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/valyala/fasthttp"
+)
 
 var (
 	ErrServiceUnavailable = errors.New("service unavailable")
@@ -9,4 +15,20 @@ var (
 	ErrMethodNotAllow     = errors.New("method not allow")
 	ErrHostNotAllow       = errors.New("host not allow")
 	ErrAuthFailed         = errors.New("unauthorized")
+	ErrIPNotAllow         = errors.New("ip not allow")
+	ErrInvalidBody        = errors.New("invalid request body")
+	ErrBodyTooLarge       = errors.New("request body too large")
+	ErrCorsNotAllow       = errors.New("cors request not allow")
+	ErrUnsupportedMedia   = errors.New("unsupported media type")
+	ErrNotImplemented     = errors.New("not implemented")
+	ErrGatewayTimeout     = errors.New("gateway timeout")
 )
+
+// IsTimeout 判断转发请求的错误是否为超时
+func IsTimeout(err error) bool {
+	if err == fasthttp.ErrTimeout || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var ne net.Error
+	return errors.As(err, &ne) && ne.Timeout()
+}
@@ -1,6 +1,10 @@
 package core
 
 import (
+	"regexp"
+	"sort"
+	"strings"
+
 	"github.com/recallsong/cliframe/cobrax"
 	"github.com/recallsong/go-utils/lang"
 	"github.com/recallsong/go-utils/reflectx"
@@ -8,42 +12,145 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-type Hosts map[string]*Host
+// Hosts 虚拟主机匹配，优先级：精确匹配 > 最长的通配符后缀(*.example.com) > 正则(~regex) > *，
+// 通配符可以带端口(*.example.com:8080)，只匹配该端口的请求，正则匹配去掉端口后的主机名
+type Hosts struct {
+	exact     map[string]*Host
+	wildcards []*Host
+	regexps   []*Host
+	any       *Host
+}
+
+func NewHosts(items map[string]*meta.Host) *Hosts {
+	hs := &Hosts{exact: make(map[string]*Host)}
+	for _, item := range items {
+		hs.Add(NewHost(item))
+	}
+	hs.sort()
+	return hs
+}
+
+func (hs *Hosts) Add(h *Host) {
+	switch {
+	case h.Value == "*":
+		hs.any = h
+	case h.Regex != nil:
+		hs.regexps = append(hs.regexps, h)
+	case len(h.Suffix) > 0:
+		hs.wildcards = append(hs.wildcards, h)
+	case len(h.Value) > 0:
+		hs.exact[h.Value] = h
+	}
+}
+
+func (hs *Hosts) sort() {
+	sort.Slice(hs.wildcards, func(i, j int) bool {
+		if len(hs.wildcards[i].Suffix) != len(hs.wildcards[j].Suffix) {
+			return len(hs.wildcards[i].Suffix) > len(hs.wildcards[j].Suffix)
+		}
+		if len(hs.wildcards[i].Port) != len(hs.wildcards[j].Port) {
+			return len(hs.wildcards[i].Port) > len(hs.wildcards[j].Port)
+		}
+		return hs.wildcards[i].Meta.Id < hs.wildcards[j].Meta.Id
+	})
+	sort.Slice(hs.regexps, func(i, j int) bool {
+		return hs.regexps[i].Meta.Id < hs.regexps[j].Meta.Id
+	})
+}
+
+func (hs *Hosts) Len() int {
+	if hs == nil {
+		return 0
+	}
+	n := len(hs.exact) + len(hs.wildcards) + len(hs.regexps)
+	if hs.any != nil {
+		n++
+	}
+	return n
+}
+
+// Match 根据请求的Host查找对应的配置
+func (hs *Hosts) Match(host string) *Host {
+	if hs == nil {
+		return nil
+	}
+	host = strings.ToLower(host)
+	if h, ok := hs.exact[host]; ok {
+		return h
+	}
+	name := stripHostPort(host)
+	if h, ok := hs.exact[name]; ok {
+		return h
+	}
+	port := strings.TrimPrefix(host[len(name):], ":")
+	for _, h := range hs.wildcards {
+		if len(h.Port) > 0 && h.Port != port {
+			continue
+		}
+		if strings.HasSuffix(name, h.Suffix) && len(name) > len(h.Suffix) {
+			return h
+		}
+	}
+	for _, h := range hs.regexps {
+		if h.Regex.MatchString(name) {
+			return h
+		}
+	}
+	return hs.any
+}
 
-func (hs Hosts) ValidateHost(ctx *RequestContext) bool {
+func (hs *Hosts) ValidateHost(ctx *RequestContext) bool {
 	host := reflectx.BytesToString(ctx.ReqCtx.Host())
-	if h, ok := hs[host]; ok {
-		if h.Meta.Kind == meta.HostKind_Deny {
-			if cobrax.Flags.Debug {
-				log.Debug("[Hosts] host %s denied", host)
-			}
-			return false
-		}
-		ctx.Host = h
-		return true
-	} else {
-		if h, ok := hs["*"]; ok {
-			if h.Meta.Kind == meta.HostKind_Deny {
-				if cobrax.Flags.Debug {
-					log.Debugf("[Hosts] host %s denied (by *)", host)
-				}
-				return false
-			}
-			ctx.Host = h
-			return true
+	h := hs.Match(host)
+	if h == nil {
+		if cobrax.Flags.Debug {
+			log.Debugf("[Hosts] host %s not defined", host)
 		}
+		return true
 	}
-	if cobrax.Flags.Debug {
-		log.Debugf("[Hosts] host %s not defined", host)
+	if h.Meta.Kind == meta.HostKind_Deny {
+		if cobrax.Flags.Debug {
+			log.Debugf("[Hosts] host %s denied (by %s)", host, h.Meta.Value)
+		}
+		return false
 	}
+	ctx.Host = h
 	return true
 }
 
 type Host struct {
-	_    lang.NoCopy
-	Meta *meta.Host
+	_      lang.NoCopy
+	Meta   *meta.Host
+	Value  string
+	Suffix string
+	Port   string
+	Regex  *regexp.Regexp
 }
 
 func NewHost(m *meta.Host) *Host {
-	return &Host{Meta: m}
+	h := &Host{Meta: m}
+	switch {
+	case strings.HasPrefix(m.Value, "~"):
+		re, err := regexp.Compile(m.Value[1:])
+		if err != nil {
+			log.Errorf("[Hosts] invalid host regexp %s : %s", m.Value, err)
+			return h
+		}
+		h.Regex = re
+	case strings.HasPrefix(m.Value, "*."):
+		suffix := strings.ToLower(m.Value[1:])
+		h.Suffix = stripHostPort(suffix)
+		h.Port = strings.TrimPrefix(suffix[len(h.Suffix):], ":")
+	default:
+		h.Value = strings.ToLower(m.Value)
+	}
+	return h
+}
+
+func stripHostPort(host string) string {
+	idx := strings.LastIndexByte(host, ':')
+	if idx < 0 || idx < strings.LastIndexByte(host, ']') {
+		return host
+	}
+	return host[:idx]
 }
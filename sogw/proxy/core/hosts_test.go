@@ -0,0 +1,76 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostsMatch(t *testing.T) {
+	hs := NewHosts(map[string]*meta.Host{
+		"1": &meta.Host{Id: "1", Value: "api.example.com"},
+		"2": &meta.Host{Id: "2", Value: "*.example.com"},
+		"3": &meta.Host{Id: "3", Value: "*.eu.example.com"},
+		"4": &meta.Host{Id: "4", Value: `~^tenant-\d+\.test\.com$`},
+		"5": &meta.Host{Id: "5", Value: "localhost:8080"},
+		"6": &meta.Host{Id: "6", Value: "*"},
+	})
+	assert.Equal(t, 6, hs.Len())
+	tests := map[string]string{
+		"api.example.com":      "1",
+		"API.example.com:8080": "1",
+		"foo.example.com":      "2",
+		"a.b.example.com":      "2",
+		"foo.eu.example.com":   "3",
+		"example.com":          "6",
+		"tenant-12.test.com":   "4",
+		"tenant-x.test.com":    "6",
+		"localhost:8080":       "5",
+		"localhost:9090":       "6",
+		"[::1]:8080":           "6",
+	}
+	for host, id := range tests {
+		h := hs.Match(host)
+		if assert.NotNil(t, h, host) {
+			assert.Equal(t, id, h.Meta.Id, host)
+		}
+	}
+
+	hs = NewHosts(map[string]*meta.Host{
+		"1": &meta.Host{Id: "1", Value: "example.com"},
+	})
+	assert.Nil(t, hs.Match("foo.example.com"))
+
+	hs = NewHosts(map[string]*meta.Host{
+		"1": &meta.Host{Id: "1", Value: "*.a.com:8080"},
+		"2": &meta.Host{Id: "2", Value: "*.a.com"},
+	})
+	assert.Equal(t, "1", hs.Match("x.a.com:8080").Meta.Id)
+	assert.Equal(t, "2", hs.Match("x.a.com:9090").Meta.Id)
+	assert.Equal(t, "2", hs.Match("x.a.com").Meta.Id)
+}
+
+func TestHostValid(t *testing.T) {
+	for value, ok := range map[string]bool{
+		"*":            true,
+		"*.a.com":      true,
+		"*.a.com:8080": true,
+		"~^a+\\.com$":  true,
+		"~(":           false,
+		"*.":           false,
+		"*.a.com:x":    false,
+		"*.a.com:1:2":  false,
+		"a.*.com":      false,
+	} {
+		err := (&meta.Host{Id: "1", Value: value}).Valid()
+		assert.Equal(t, ok, err == nil, value)
+	}
+}
+
+func TestStripHostPort(t *testing.T) {
+	assert.Equal(t, "example.com", stripHostPort("example.com:80"))
+	assert.Equal(t, "example.com", stripHostPort("example.com"))
+	assert.Equal(t, "[::1]", stripHostPort("[::1]:80"))
+	assert.Equal(t, "[::1]", stripHostPort("[::1]"))
+}
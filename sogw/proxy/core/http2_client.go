@@ -0,0 +1,121 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/valyala/fasthttp"
+	"golang.org/x/net/http2"
+)
+
+const (
+	http2ReadIdleTimeout = 30 * time.Second
+	http2PingTimeout     = 15 * time.Second
+)
+
+// newHttp2Transport 创建HTTP/2连接池，h2c使用prior-knowledge，h2通过TLS ALPN协商
+func newHttp2Transport(protocol meta.ServerProtocol, tlsCfg *tls.Config, dialTimeout, writeTimeout time.Duration) *http2.Transport {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	t := &http2.Transport{
+		ReadIdleTimeout:  http2ReadIdleTimeout,
+		PingTimeout:      http2PingTimeout,
+		WriteByteTimeout: writeTimeout,
+	}
+	if protocol == meta.ServerProtocol_H2C {
+		t.AllowHTTP = true
+		t.DialTLS = func(network, addr string, _ *tls.Config) (net.Conn, error) {
+			return dialer.Dial(network, addr)
+		}
+	} else {
+		t.TLSClientConfig = tlsCfg.Clone()
+		t.TLSClientConfig.NextProtos = []string{http2.NextProtoTLS}
+		t.DialTLS = func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+			return tls.DialWithDialer(dialer, network, addr, cfg)
+		}
+	}
+	return t
+}
+
+// forwardHttp2 通过HTTP/2多路复用的连接转发请求，timeout>0时限制整个请求的时间
+func forwardHttp2(t *http2.Transport, scheme, addr, host string, timeout time.Duration, freq *fasthttp.Request, fresp *fasthttp.Response) error {
+	req, err := http.NewRequest(string(freq.Header.Method()), scheme+"://"+addr+string(freq.URI().RequestURI()), nil)
+	if err != nil {
+		return err
+	}
+	if timeout > 0 {
+		c, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		req = req.WithContext(c)
+	}
+	var bodyErr chan error
+	if freq.IsBodyStream() {
+		// 流式的body通过管道边读边发，读取错误时返回该错误
+		pr, pw := io.Pipe()
+		defer pr.Close()
+		bodyErr = make(chan error, 1)
+		go func() {
+			err := freq.BodyWriteTo(pw)
+			bodyErr <- err
+			pw.CloseWithError(err)
+		}()
+		req.Body = pr
+		req.ContentLength = int64(freq.Header.ContentLength())
+		if req.ContentLength < 0 {
+			req.ContentLength = -1
+		}
+	} else if body := freq.Body(); len(body) > 0 {
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+	}
+	req.Host = host
+	freq.Header.VisitAll(func(k, v []byte) {
+		switch key := string(k); key {
+		case fasthttp.HeaderHost, fasthttp.HeaderContentLength, fasthttp.HeaderConnection, fasthttp.HeaderTransferEncoding:
+		default:
+			req.Header.Add(key, string(v))
+		}
+	})
+	resp, err := t.RoundTrip(req)
+	if err != nil {
+		select {
+		case berr := <-bodyErr:
+			if berr != nil {
+				return berr
+			}
+		default:
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	fresp.Reset()
+	fresp.SetStatusCode(resp.StatusCode)
+	for k, vv := range resp.Header {
+		if k == fasthttp.HeaderContentLength {
+			continue
+		}
+		for _, v := range vv {
+			fresp.Header.Add(k, v)
+		}
+	}
+	for k, vv := range resp.Trailer {
+		if fresp.Header.AddTrailer(k) != nil {
+			continue
+		}
+		for _, v := range vv {
+			fresp.Header.Add(k, v)
+		}
+	}
+	fresp.SetBody(body)
+	return nil
+}
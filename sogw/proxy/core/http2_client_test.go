@@ -0,0 +1,132 @@
+package core
+
+import (
+	"encoding/pem"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func TestForwardH2C(t *testing.T) {
+	backend := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, 2, r.ProtoMajor)
+		assert.Equal(t, "api.example.com", r.Host)
+		assert.Equal(t, "/users?id=1", r.URL.RequestURI())
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Add("X-Tag", "a")
+		w.Header().Add("X-Tag", "b")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte("echo " + string(body)))
+	}), &http2.Server{}))
+	defer backend.Close()
+	addr := strings.TrimPrefix(backend.URL, "http://")
+
+	svr := NewServer(&meta.Server{Addr: addr, Host: "api.example.com", Protocol: meta.ServerProtocol_H2C})
+	assert.True(t, svr.upstream == NewServer(&meta.Server{Addr: addr, Protocol: meta.ServerProtocol_H2C}).upstream)
+	freq, fresp := &fasthttp.Request{}, &fasthttp.Response{}
+	freq.Header.SetMethod("POST")
+	freq.SetRequestURI("http://example.com/users?id=1")
+	freq.SetBodyString("hello")
+	assert.Nil(t, svr.Forward(freq, fresp))
+	assert.Equal(t, http.StatusCreated, fresp.StatusCode())
+	assert.Equal(t, "text/plain", string(fresp.Header.ContentType()))
+	assert.Equal(t, "echo hello", string(fresp.Body()))
+	var tags []string
+	fresp.Header.VisitAll(func(k, v []byte) {
+		if string(k) == "X-Tag" {
+			tags = append(tags, string(v))
+		}
+	})
+	assert.Equal(t, []string{"a", "b"}, tags)
+}
+
+func TestForwardTLS(t *testing.T) {
+	backend := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.RequestURI()))
+	}))
+	defer backend.Close()
+	addr := strings.TrimPrefix(backend.URL, "https://")
+	ca := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: backend.Certificate().Raw}))
+
+	svr := NewServer(&meta.Server{Addr: addr, Client: &meta.UpstreamClient{Scheme: "https", CaCert: ca}})
+	assert.Nil(t, svr.err)
+	freq, fresp := &fasthttp.Request{}, &fasthttp.Response{}
+	freq.SetRequestURI("http://example.com/users?id=1")
+	assert.Nil(t, svr.Forward(freq, fresp))
+	assert.Equal(t, "/users?id=1", string(fresp.Body()))
+
+	svr = NewServer(&meta.Server{Addr: addr, Client: &meta.UpstreamClient{Scheme: "https"}})
+	freq.SetRequestURI("http://example.com/users?id=1")
+	assert.NotNil(t, svr.Forward(freq, fresp))
+
+	svr = NewServer(&meta.Server{Addr: addr, Client: &meta.UpstreamClient{Scheme: "https", CaCert: "-----BEGIN CERTIFICATE-----\n"}})
+	assert.NotNil(t, svr.err)
+	assert.Equal(t, svr.err, svr.Forward(freq, fresp))
+}
+
+func TestUpstreamClientCache(t *testing.T) {
+	m := &meta.Server{Id: "s1", Addr: "127.0.0.1:1"}
+	s1 := newServer(m, &meta.UpstreamClient{ReadTimeout: 1000})
+	s2 := newServer(m, &meta.UpstreamClient{ReadTimeout: 1000})
+	assert.True(t, s1.upstream == s2.upstream)
+	s3 := newServer(m, &meta.UpstreamClient{ReadTimeout: 2000})
+	assert.True(t, s1.upstream != s3.upstream)
+	assert.True(t, s3.upstream == upstreamClients["s1"])
+
+	s1.Close()
+	s2.Close()
+	assert.True(t, s3.upstream == upstreamClients["s1"])
+	s3.Close()
+	_, ok := upstreamClients["s1"]
+	assert.False(t, ok)
+}
+
+func TestForwardH2CStream(t *testing.T) {
+	backend := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return
+		}
+		w.Header().Set("Trailer", "Grpc-Status")
+		w.Write(body)
+		w.Header().Set("Grpc-Status", "0")
+	}), &http2.Server{}))
+	defer backend.Close()
+	addr := strings.TrimPrefix(backend.URL, "http://")
+
+	svr := NewServer(&meta.Server{Addr: addr, Protocol: meta.ServerProtocol_H2C})
+	freq, fresp := &fasthttp.Request{}, &fasthttp.Response{}
+	freq.Header.SetMethod("POST")
+	freq.SetRequestURI("http://example.com/stream")
+	freq.SetBodyStream(strings.NewReader("hello stream"), -1)
+	assert.Nil(t, svr.Forward(freq, fresp))
+	assert.Equal(t, "hello stream", string(fresp.Body()))
+	assert.Equal(t, "0", string(fresp.Header.Peek("Grpc-Status")))
+	var trailers []string
+	fresp.Header.VisitAllTrailer(func(v []byte) {
+		trailers = append(trailers, string(v))
+	})
+	assert.Equal(t, []string{"Grpc-Status"}, trailers)
+
+	freq.SetBodyStream(io.MultiReader(strings.NewReader("hello"), &errReader{ErrBodyTooLarge}), -1)
+	assert.True(t, errors.Is(svr.Forward(freq, fresp), ErrBodyTooLarge))
+}
+
+type errReader struct {
+	err error
+}
+
+func (r *errReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
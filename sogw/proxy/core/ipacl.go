@@ -0,0 +1,160 @@
+package core
+
+import (
+	"net"
+	"strings"
+
+	"github.com/recallsong/go-utils/lang"
+	"github.com/recallsong/sogw/store/meta"
+	log "github.com/sirupsen/logrus"
+)
+
+type IPAcl struct {
+	_    lang.NoCopy
+	Meta *meta.IPAcl
+	Nets []*net.IPNet
+}
+
+func NewIPAcl(m *meta.IPAcl) *IPAcl {
+	acl := &IPAcl{Meta: m}
+	for _, cidr := range m.Cidrs {
+		n, err := ParseCIDR(cidr)
+		if err != nil {
+			log.Errorf("[ipacl] invalid cidr %s in acl %s : %s", cidr, m.Id, err)
+			continue
+		}
+		acl.Nets = append(acl.Nets, n)
+	}
+	return acl
+}
+
+func (a *IPAcl) Contains(ip net.IP) bool {
+	for _, n := range a.Nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseCIDR 解析CIDR，单个IP地址视为/32或/128
+func ParseCIDR(cidr string) (*net.IPNet, error) {
+	if strings.IndexByte(cidr, '/') < 0 {
+		ip := net.ParseIP(cidr)
+		if ip == nil {
+			return nil, &net.ParseError{Type: "IP address", Text: cidr}
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+	_, n, err := net.ParseCIDR(cidr)
+	return n, err
+}
+
+// IPAclList 同一作用域下的访问控制列表
+type IPAclList []*IPAcl
+
+// Allow 命中任意Deny列表则拒绝；存在Allow列表时，必须命中其中之一
+func (l IPAclList) Allow(ip net.IP) bool {
+	if len(l) <= 0 {
+		return true
+	}
+	hasAllow, allowed := false, false
+	for _, acl := range l {
+		if acl.Meta.Kind == meta.IPAclKind_IPDeny {
+			if ip == nil || acl.Contains(ip) {
+				return false
+			}
+		} else {
+			hasAllow = true
+			if !allowed && ip != nil && acl.Contains(ip) {
+				allowed = true
+			}
+		}
+	}
+	return !hasAllow || allowed
+}
+
+// IPAcls 按作用域划分的IP访问控制列表
+type IPAcls struct {
+	Global   IPAclList
+	Routes   map[string]IPAclList
+	Services map[string]IPAclList
+	Apis     map[string]IPAclList
+}
+
+func NewIPAcls(items map[string]*meta.IPAcl) *IPAcls {
+	acls := &IPAcls{
+		Routes:   make(map[string]IPAclList),
+		Services: make(map[string]IPAclList),
+		Apis:     make(map[string]IPAclList),
+	}
+	for _, item := range items {
+		if item.Status == meta.Status_Close {
+			continue
+		}
+		acl := NewIPAcl(item)
+		switch {
+		case len(item.Route) > 0:
+			acls.Routes[item.Route] = append(acls.Routes[item.Route], acl)
+		case len(item.ApiId) > 0:
+			key := apiAclKey(item.Service, item.ApiId)
+			acls.Apis[key] = append(acls.Apis[key], acl)
+		case len(item.Service) > 0:
+			acls.Services[item.Service] = append(acls.Services[item.Service], acl)
+		default:
+			acls.Global = append(acls.Global, acl)
+		}
+	}
+	return acls
+}
+
+func (acls *IPAcls) Len() int {
+	if acls == nil {
+		return 0
+	}
+	n := len(acls.Global)
+	for _, l := range acls.Routes {
+		n += len(l)
+	}
+	for _, l := range acls.Services {
+		n += len(l)
+	}
+	for _, l := range acls.Apis {
+		n += len(l)
+	}
+	return n
+}
+
+// AllowGlobal 检查全局的访问控制列表
+func (acls *IPAcls) AllowGlobal(ip net.IP) bool {
+	if acls == nil {
+		return true
+	}
+	return acls.Global.Allow(ip)
+}
+
+// AllowScoped 检查路由、服务以及api的访问控制列表
+func (acls *IPAcls) AllowScoped(ip net.IP, route, service, apiId string) bool {
+	if acls == nil {
+		return true
+	}
+	if len(route) > 0 && !acls.Routes[route].Allow(ip) {
+		return false
+	}
+	if len(service) > 0 {
+		if !acls.Services[service].Allow(ip) {
+			return false
+		}
+		if len(apiId) > 0 && !acls.Apis[apiAclKey(service, apiId)].Allow(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func apiAclKey(service, apiId string) string {
+	return service + "/" + apiId
+}
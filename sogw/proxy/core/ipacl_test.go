@@ -0,0 +1,45 @@
+package core
+
+import (
+	"net"
+	"testing"
+
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCIDR(t *testing.T) {
+	n, err := ParseCIDR("10.0.0.1")
+	assert.Nil(t, err)
+	assert.Equal(t, "10.0.0.1/32", n.String())
+	n, err = ParseCIDR("::1")
+	assert.Nil(t, err)
+	assert.Equal(t, "::1/128", n.String())
+	n, err = ParseCIDR("192.168.1.0/24")
+	assert.Nil(t, err)
+	assert.Equal(t, "192.168.1.0/24", n.String())
+	_, err = ParseCIDR("abc")
+	assert.NotNil(t, err)
+}
+
+func TestIPAcls(t *testing.T) {
+	acls := NewIPAcls(map[string]*meta.IPAcl{
+		"1": &meta.IPAcl{Id: "1", Kind: meta.IPAclKind_IPDeny, Cidrs: []string{"10.0.0.0/8"}},
+		"2": &meta.IPAcl{Id: "2", Kind: meta.IPAclKind_IPAllow, Service: "s1", Cidrs: []string{"192.168.1.0/24"}},
+		"3": &meta.IPAcl{Id: "3", Kind: meta.IPAclKind_IPDeny, Service: "s1", ApiId: "a1", Cidrs: []string{"192.168.1.100"}},
+		"4": &meta.IPAcl{Id: "4", Kind: meta.IPAclKind_IPDeny, Status: meta.Status_Close, Cidrs: []string{"0.0.0.0/0"}},
+	})
+	assert.Equal(t, 3, acls.Len())
+
+	assert.False(t, acls.AllowGlobal(net.ParseIP("10.1.2.3")))
+	assert.True(t, acls.AllowGlobal(net.ParseIP("192.168.1.100")))
+
+	assert.True(t, acls.AllowScoped(net.ParseIP("192.168.1.10"), "", "s1", "a1"))
+	assert.False(t, acls.AllowScoped(net.ParseIP("192.168.1.100"), "", "s1", "a1"))
+	assert.True(t, acls.AllowScoped(net.ParseIP("192.168.1.100"), "", "s1", "a2"))
+	assert.False(t, acls.AllowScoped(net.ParseIP("172.16.0.1"), "", "s1", ""))
+	assert.True(t, acls.AllowScoped(net.ParseIP("172.16.0.1"), "", "s2", ""))
+
+	var empty *IPAcls
+	assert.True(t, empty.AllowGlobal(nil))
+}
@@ -1,7 +1,6 @@
 package core
 
 import (
-	"net"
 	"sync/atomic"
 
 	"github.com/recallsong/go-utils/lang"
@@ -45,13 +44,10 @@ func (lb *IPHashLB) Select(ctx *RequestContext, servers []*Server) *Server {
 	if 0 >= num {
 		return nil
 	}
-	addr, err := net.ResolveIPAddr("ip", ctx.GetRealClientAddr())
-	if err != nil {
-		return servers[uint64(len(err.Error()))%num]
-	}
+	ip := ctx.GetRealClientIP()
 	var sum uint64
-	for i, c := len(addr.IP)-1, 0; i >= 0 && c < 4; c, i = c+1, i-1 {
-		sum += uint64(addr.IP[i])
+	for i, c := len(ip)-1, 0; i >= 0 && c < 4; c, i = c+1, i-1 {
+		sum += uint64(ip[i])
 	}
 	if sum <= 0 {
 		return servers[0]
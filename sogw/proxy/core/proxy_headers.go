@@ -0,0 +1,128 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/recallsong/go-utils/reflectx"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/valyala/fasthttp"
+)
+
+const defaultViaName = "sogw"
+
+// hopHeaders 逐跳头部，只对单个连接有效，不能转发(RFC 7230 6.1)
+var hopHeaders = []string{
+	"Connection",
+	"Proxy-Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+type headerPeekDeleter interface {
+	Peek(key string) []byte
+	Del(key string)
+}
+
+// RemoveHopHeaders 删除逐跳头部以及Connection头中列出的头部
+func RemoveHopHeaders(h headerPeekDeleter) {
+	if conn := h.Peek("Connection"); len(conn) > 0 {
+		for _, name := range strings.Split(string(conn), ",") {
+			if name = strings.TrimSpace(name); len(name) > 0 {
+				h.Del(name)
+			}
+		}
+	}
+	for _, name := range hopHeaders {
+		h.Del(name)
+	}
+}
+
+// IsWebSocket 是否是WebSocket的升级请求
+func IsWebSocket(h *fasthttp.RequestHeader) bool {
+	if !strings.EqualFold(reflectx.BytesToString(h.Peek("Upgrade")), "websocket") {
+		return false
+	}
+	for _, token := range strings.Split(reflectx.BytesToString(h.Peek("Connection")), ",") {
+		if strings.EqualFold(strings.TrimSpace(token), "upgrade") {
+			return true
+		}
+	}
+	return false
+}
+
+// SetProxyHeaders 设置转发请求的 X-Forwarded-*、X-Real-IP、Forwarded 以及 Via 头
+func SetProxyHeaders(ctx *RequestContext, cfg *meta.ProxyHeaders) {
+	if cfg == nil {
+		cfg = &meta.ProxyHeaders{}
+	}
+	reqc := ctx.ReqCtx
+	header := &ctx.ForwardReq.Header
+	trusted, unix := ctx.peerTrusted(), ctx.unixPeer()
+	remote := reqc.RemoteIP().String()
+	proto := "http"
+	if reqc.IsTLS() {
+		proto = "https"
+	}
+	host := reflectx.BytesToString(reqc.Host())
+	if !cfg.DisableXForwarded {
+		if xff := reqc.Request.Header.Peek("X-Forwarded-For"); unix {
+			// unix socket没有对端地址，保留本机代理设置的X-Forwarded-For
+			if len(xff) <= 0 {
+				header.Del("X-Forwarded-For")
+			}
+		} else if trusted && len(xff) > 0 {
+			header.Set("X-Forwarded-For", reflectx.BytesToString(xff)+", "+remote)
+		} else {
+			header.Set("X-Forwarded-For", remote)
+		}
+		if !trusted || len(reqc.Request.Header.Peek("X-Forwarded-Proto")) <= 0 {
+			header.Set("X-Forwarded-Proto", proto)
+		}
+		if !trusted || len(reqc.Request.Header.Peek("X-Forwarded-Host")) <= 0 {
+			header.Set("X-Forwarded-Host", host)
+		}
+	}
+	if !cfg.DisableXRealIp {
+		header.Set("X-Real-IP", ctx.GetRealClientAddr())
+	}
+	if cfg.Forwarded {
+		node := forwardedNode(remote)
+		if unix {
+			node = "unknown"
+		}
+		elem := "for=" + node + ";host=\"" + host + "\";proto=" + proto
+		if fwd := reqc.Request.Header.Peek("Forwarded"); trusted && len(fwd) > 0 {
+			header.Set("Forwarded", reflectx.BytesToString(fwd)+", "+elem)
+		} else {
+			header.Set("Forwarded", elem)
+		}
+	}
+	if !cfg.DisableVia {
+		name := cfg.Via
+		if len(name) <= 0 {
+			name = defaultViaName
+		}
+		version := "1.1 "
+		if !reqc.Request.Header.IsHTTP11() {
+			version = "1.0 "
+		}
+		if via := reqc.Request.Header.Peek("Via"); len(via) > 0 {
+			header.Set("Via", reflectx.BytesToString(via)+", "+version+name)
+		} else {
+			header.Set("Via", version+name)
+		}
+	}
+}
+
+// forwardedNode IPv6地址需要加上引号和中括号(RFC 7239 6)
+func forwardedNode(ip string) string {
+	if strings.IndexByte(ip, ':') >= 0 {
+		return "\"[" + ip + "]\""
+	}
+	return ip
+}
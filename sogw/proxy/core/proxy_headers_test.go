@@ -0,0 +1,91 @@
+package core
+
+import (
+	"net"
+	"testing"
+
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func newTestRequestContext(remote string, headers map[string]string) *RequestContext {
+	var req fasthttp.Request
+	req.SetRequestURI("http://api.example.com/v1/users")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	reqc := &fasthttp.RequestCtx{}
+	reqc.Init(&req, &net.TCPAddr{IP: net.ParseIP(remote), Port: 1234}, nil)
+	ctx := NewRequestContext(reqc)
+	ctx.ForwardReq = &fasthttp.Request{}
+	reqc.Request.CopyTo(ctx.ForwardReq)
+	return ctx
+}
+
+func TestRemoveHopHeaders(t *testing.T) {
+	var h fasthttp.RequestHeader
+	h.Set("Connection", "keep-alive, X-Custom")
+	h.Set("X-Custom", "1")
+	h.Set("Keep-Alive", "timeout=5")
+	h.Set("Upgrade", "websocket")
+	h.Set("X-Other", "2")
+	RemoveHopHeaders(&h)
+	assert.Equal(t, 0, len(h.Peek("X-Custom")))
+	assert.Equal(t, 0, len(h.Peek("Keep-Alive")))
+	assert.Equal(t, 0, len(h.Peek("Upgrade")))
+	assert.Equal(t, "2", string(h.Peek("X-Other")))
+}
+
+func TestSetProxyHeaders(t *testing.T) {
+	ctx := newTestRequestContext("1.2.3.4", map[string]string{"X-Forwarded-For": "9.9.9.9", "Via": "1.1 cdn"})
+	SetProxyHeaders(ctx, nil)
+	h := &ctx.ForwardReq.Header
+	assert.Equal(t, "1.2.3.4", string(h.Peek("X-Forwarded-For")))
+	assert.Equal(t, "http", string(h.Peek("X-Forwarded-Proto")))
+	assert.Equal(t, "api.example.com", string(h.Peek("X-Forwarded-Host")))
+	assert.Equal(t, "1.2.3.4", string(h.Peek("X-Real-IP")))
+	assert.Equal(t, "1.1 cdn, 1.1 sogw", string(h.Peek("Via")))
+	assert.Equal(t, 0, len(h.Peek("Forwarded")))
+
+	ctx = newTestRequestContext("10.0.0.1", map[string]string{"X-Forwarded-For": "9.9.9.9", "X-Forwarded-Proto": "https"})
+	ctx.TrustedProxies, _ = NewTrustedProxies([]string{"10.0.0.0/8"})
+	SetProxyHeaders(ctx, &meta.ProxyHeaders{DisableVia: true, Forwarded: true})
+	h = &ctx.ForwardReq.Header
+	assert.Equal(t, "9.9.9.9, 10.0.0.1", string(h.Peek("X-Forwarded-For")))
+	assert.Equal(t, "https", string(h.Peek("X-Forwarded-Proto")))
+	assert.Equal(t, "9.9.9.9", string(h.Peek("X-Real-IP")))
+	assert.Equal(t, `for=10.0.0.1;host="api.example.com";proto=http`, string(h.Peek("Forwarded")))
+	assert.Equal(t, 0, len(h.Peek("Via")))
+}
+
+func TestIsWebSocket(t *testing.T) {
+	var h fasthttp.RequestHeader
+	assert.False(t, IsWebSocket(&h))
+	h.Set("Upgrade", "WebSocket")
+	h.Set("Connection", "keep-alive, Upgrade")
+	assert.True(t, IsWebSocket(&h))
+	h.Set("Connection", "keep-alive")
+	assert.False(t, IsWebSocket(&h))
+}
+
+func TestSetProxyHeadersUnixSocket(t *testing.T) {
+	var req fasthttp.Request
+	req.SetRequestURI("http://api.example.com/v1/users")
+	req.Header.Set("X-Forwarded-For", "9.9.9.9")
+	req.Header.Set("X-Forwarded-Proto", "https")
+	req.Header.Set("X-Forwarded-Host", "www.example.com")
+	req.Header.Set("Forwarded", "for=9.9.9.9")
+	reqc := &fasthttp.RequestCtx{}
+	reqc.Init(&req, &net.UnixAddr{Name: "/tmp/sogw.sock", Net: "unix"}, nil)
+	ctx := NewRequestContext(reqc)
+	ctx.ForwardReq = &fasthttp.Request{}
+	reqc.Request.CopyTo(ctx.ForwardReq)
+	SetProxyHeaders(ctx, &meta.ProxyHeaders{Forwarded: true})
+	h := &ctx.ForwardReq.Header
+	assert.Equal(t, "9.9.9.9", string(h.Peek("X-Forwarded-For")))
+	assert.Equal(t, "https", string(h.Peek("X-Forwarded-Proto")))
+	assert.Equal(t, "www.example.com", string(h.Peek("X-Forwarded-Host")))
+	assert.Equal(t, "9.9.9.9", string(h.Peek("X-Real-IP")))
+	assert.Equal(t, `for=9.9.9.9, for=unknown;host="api.example.com";proto=http`, string(h.Peek("Forwarded")))
+}
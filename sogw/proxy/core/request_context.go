@@ -1,13 +1,13 @@
 package core
 
 import (
-	"strconv"
-	"strings"
+	"io"
+	"io/ioutil"
+	"net"
 	"time"
 
 	"github.com/recallsong/go-utils/lang"
-	"github.com/recallsong/go-utils/reflectx"
-	"github.com/recallsong/sogw/sogw/proxy/router"
+	"github.com/recallsong/sogw/sogw/proxy/tracing"
 	"github.com/valyala/fasthttp"
 )
 
@@ -22,13 +22,26 @@ type RequestContext struct {
 	Attrs       map[string]interface{}
 	PathNames   []string
 	PathValues  []string
-
-	Hosts    Hosts
-	Auths    map[string]*Auth
-	Services map[string]*Service
-	Router   *router.Router
+	clientIP    net.IP
+
+	RequestId      string
+	TrustedProxies TrustedProxies
+	Span           *tracing.Span
+	Identity       string
+	UpstreamCost   time.Duration
+	Deadline       time.Time
+	BodyLimit      int
+	BodyErr        error
+
+	Hosts       *Hosts
+	Auths       map[string]*Auth
+	Services    map[string]*Service
+	Routers     *Routers
+	IPAcls      *IPAcls
+	Descriptors *Descriptors
 
 	Host          *Host
+	Route         *Route
 	ValueContexts ValueContexts
 	Service       *Service
 	Api           *Api
@@ -67,11 +80,53 @@ func (c *RequestContext) ForwardResponse() *fasthttp.Response {
 }
 
 func (c *RequestContext) GetRealClientAddr() string {
-	xforward := c.ReqCtx.Request.Header.Peek("X-Forwarded-For")
-	if nil == xforward {
-		return strings.SplitN(c.ReqCtx.RemoteAddr().String(), ":", 2)[0]
+	return c.GetRealClientIP().String()
+}
+
+func (c *RequestContext) GetRealClientIP() net.IP {
+	if c.clientIP == nil {
+		remote := c.ReqCtx.RemoteIP()
+		if c.peerTrusted() {
+			c.clientIP = c.TrustedProxies.resolveChain(remote, forwardedChain(&c.ReqCtx.Request.Header))
+		} else {
+			c.clientIP = remote
+		}
 	}
-	return strings.SplitN(reflectx.BytesToString(xforward), ",", 2)[0]
+	return c.clientIP
+}
+
+// peerTrusted 连接的对端是否是可信代理，unix socket的对端是本机的代理，总是可信
+func (c *RequestContext) peerTrusted() bool {
+	return c.unixPeer() || c.TrustedProxies.Contains(c.ReqCtx.RemoteIP())
+}
+
+// unixPeer 请求是否来自unix socket，此时没有对端的IP地址
+func (c *RequestContext) unixPeer() bool {
+	_, ok := c.ReqCtx.RemoteAddr().(*net.UnixAddr)
+	return ok
+}
+
+// ReadBody 读取请求body，以流的方式接收的body最多读取BodyLimit字节，失败时记录到BodyErr并返回nil
+func (c *RequestContext) ReadBody() []byte {
+	if c.BodyErr != nil {
+		return nil
+	}
+	req := &c.ReqCtx.Request
+	stream := c.ReqCtx.RequestBodyStream()
+	if stream == nil || c.BodyLimit <= 0 {
+		return req.Body()
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(stream, int64(c.BodyLimit)+1))
+	if err != nil {
+		c.BodyErr = ErrInvalidBody
+		return nil
+	}
+	if len(body) > c.BodyLimit {
+		c.BodyErr = ErrBodyTooLarge
+		return nil
+	}
+	req.SetBody(body)
+	return req.Body()
 }
 
 func (c *RequestContext) Error() error {
@@ -113,9 +168,3 @@ func (c *RequestContext) PathParam(name string) string {
 	}
 	return ""
 }
-
-func (c *RequestContext) WriteError(statusCode int) {
-	c.ReqCtx.Response.Reset()
-	c.ReqCtx.SetStatusCode(statusCode)
-	c.ReqCtx.SetBodyString(strconv.Itoa(statusCode) + " " + fasthttp.StatusMessage(statusCode))
-}
@@ -0,0 +1,32 @@
+package core
+
+import (
+	"github.com/pborman/uuid"
+)
+
+const DefaultRequestIdHeader = "X-Request-Id"
+
+const maxRequestIdLen = 128
+
+// InitRequestId 使用请求头中的请求ID，不存在或者不合法时生成新的ID，并写回请求头以便转发给后端
+func (c *RequestContext) InitRequestId(header string) {
+	id := c.ReqCtx.Request.Header.Peek(header)
+	if validRequestId(id) {
+		c.RequestId = string(id)
+		return
+	}
+	c.RequestId = uuid.NewRandom().String()
+	c.ReqCtx.Request.Header.Set(header, c.RequestId)
+}
+
+func validRequestId(id []byte) bool {
+	if len(id) <= 0 || len(id) > maxRequestIdLen {
+		return false
+	}
+	for _, c := range id {
+		if c <= ' ' || c >= 0x7f {
+			return false
+		}
+	}
+	return true
+}
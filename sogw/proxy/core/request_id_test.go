@@ -0,0 +1,27 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestInitRequestId(t *testing.T) {
+	ctx := NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.ReqCtx.Request.Header.Set("X-Trace-Id", "abc-123")
+	ctx.InitRequestId("X-Trace-Id")
+	assert.Equal(t, "abc-123", ctx.RequestId)
+	val, ok := getValueFromSystem(ctx, "request_id()")
+	assert.True(t, ok)
+	assert.Equal(t, "abc-123", val)
+
+	ctx = NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.ReqCtx.Request.Header.Set(DefaultRequestIdHeader, "bad id")
+	ctx.InitRequestId(DefaultRequestIdHeader)
+	assert.Equal(t, 36, len(ctx.RequestId))
+	assert.Equal(t, ctx.RequestId, string(ctx.ReqCtx.Request.Header.Peek(DefaultRequestIdHeader)))
+
+	ctx.WriteError(fasthttp.StatusNotFound)
+	assert.Equal(t, "404 Not Found, request id: "+ctx.RequestId, string(ctx.ReqCtx.Response.Body()))
+}
@@ -3,6 +3,7 @@ package core
 import (
 	"github.com/recallsong/cliframe/cobrax"
 	"github.com/recallsong/go-utils/lang"
+	"github.com/recallsong/sogw/sogw/proxy/router"
 	"github.com/recallsong/sogw/store/meta"
 	log "github.com/sirupsen/logrus"
 )
@@ -18,29 +19,46 @@ type Route struct {
 	Meta     *meta.Route
 	Context  ValueContext
 	ApiConds []*ApiCondition
+	Split    *TrafficSplit
+	Versions *ApiVersioning
+	Static   *StaticFiles
 }
 
 func NewRoute(m *meta.Route) *Route {
 	var conds []*ApiCondition
-	if len(m.ApiConds) > 0 {
-		var conds []*ApiCondition
-		for _, c := range m.ApiConds {
-			if c != nil && c.Matcher != nil {
-				conds = append(conds, &ApiCondition{
-					Matcher: *NewMatcher(c.Matcher),
-					ApiId:   c.ApiId,
-				})
-			}
+	for _, c := range m.ApiConds {
+		if c != nil && c.Matcher != nil {
+			conds = append(conds, &ApiCondition{
+				Matcher: *NewMatcher(c.Matcher),
+				ApiId:   c.ApiId,
+			})
 		}
 	}
+	var static *StaticFiles
+	if len(m.Files) > 0 {
+		static = NewStaticFiles(m.Files, m.Path)
+	}
 	return &Route{
 		Meta:     m,
 		Context:  ValueContext(m.Context),
 		ApiConds: conds,
+		Split:    NewTrafficSplit(m.Split),
+		Versions: NewApiVersioning(m.Versioning),
+		Static:   static,
 	}
 }
 
+// Dispatch 按照 条件匹配 > 按权重分流 > 路由的api > host的api 的优先级选择api，
+// 路由配置了版本选择时再选择api的对应版本
 func (r *Route) Dispatch(ctx *RequestContext) *Api {
+	api := r.dispatch(ctx)
+	if api == nil || r.Versions == nil {
+		return api
+	}
+	return r.selectVersion(ctx, api)
+}
+
+func (r *Route) dispatch(ctx *RequestContext) *Api {
 	service := r.Meta.Service
 	if r.Meta.Service == "" && ctx.Host != nil {
 		service = ctx.Host.Meta.Service
@@ -48,32 +66,13 @@ func (r *Route) Dispatch(ctx *RequestContext) *Api {
 	if r.ApiConds != nil {
 		for _, c := range r.ApiConds {
 			if c.Matcher.Match(ctx) {
-				if ser, ok := ctx.Services[service]; ok {
-					ctx.Service = ser
-					api := ser.Apis[c.ApiId]
-					if api == nil || api.Meta.Status == meta.Status_Close {
-						if cobrax.Flags.Debug {
-							if api == nil {
-								log.Debugf("[route] api (id=%s) not found", c.ApiId)
-							} else {
-								log.Debugf("[route] api (id=%s) has been closed", c.ApiId)
-							}
-						}
-						return nil
-					}
-					if ctx.Service.Config.Context != nil {
-						ctx.ValueContexts = append(ctx.ValueContexts, ctx.Service.Config.Context)
-					}
-					ctx.Api = api
-					return api
-				}
-				if cobrax.Flags.Debug {
-					log.Debugf("[route] service (id=%s) not found", r.Meta.Service)
-				}
-				return nil
+				return r.dispatchTo(ctx, service, c.ApiId)
 			}
 		}
 	}
+	if r.Split != nil {
+		return r.dispatchTo(ctx, service, r.Split.Pick(ctx))
+	}
 	if ser, ok := ctx.Services[service]; ok {
 		ctx.Service = ser
 		api := ser.Apis[r.Meta.ApiId]
@@ -103,3 +102,74 @@ func (r *Route) Dispatch(ctx *RequestContext) *Api {
 	}
 	return nil
 }
+
+func (r *Route) dispatchTo(ctx *RequestContext, service, apiId string) *Api {
+	if ser, ok := ctx.Services[service]; ok {
+		ctx.Service = ser
+		api := ser.Apis[apiId]
+		if api == nil || api.Meta.Status == meta.Status_Close {
+			if cobrax.Flags.Debug {
+				if api == nil {
+					log.Debugf("[route] api (id=%s) not found", apiId)
+				} else {
+					log.Debugf("[route] api (id=%s) has been closed", apiId)
+				}
+			}
+			return nil
+		}
+		if ctx.Service.Config.Context != nil {
+			ctx.ValueContexts = append(ctx.ValueContexts, ctx.Service.Config.Context)
+		}
+		ctx.Api = api
+		return api
+	}
+	if cobrax.Flags.Debug {
+		log.Debugf("[route] service (id=%s) not found", r.Meta.Service)
+	}
+	return nil
+}
+
+// Routers 全局路由表以及按虚拟主机划分的路由表
+type Routers struct {
+	Global *router.Router
+	Hosts  map[string]*router.Router
+}
+
+func NewRouters() *Routers {
+	return &Routers{
+		Global: router.New(),
+		Hosts:  make(map[string]*router.Router),
+	}
+}
+
+// Get 返回host对应的路由表，若不存在则返回nil
+func (rs *Routers) Get(hostId string) *router.Router {
+	if rs == nil || len(hostId) <= 0 {
+		return nil
+	}
+	return rs.Hosts[hostId]
+}
+
+// Find 优先在host的路由表中查找，找不到时回退到全局路由表
+func (rs *Routers) Find(host *Host, method, path string) (*router.Result, bool) {
+	var hostResult *router.Result
+	if host != nil {
+		if rt := rs.Get(host.Meta.Id); rt != nil {
+			result := rt.NewResult()
+			if rt.Find(method, path, result) {
+				if !result.MethodNotAllow {
+					return result, true
+				}
+				hostResult = result
+			}
+		}
+	}
+	result := rs.Global.NewResult()
+	if rs.Global.Find(method, path, result) && (!result.MethodNotAllow || hostResult == nil) {
+		return result, true
+	}
+	if hostResult != nil {
+		return hostResult, true
+	}
+	return result, false
+}
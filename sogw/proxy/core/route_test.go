@@ -0,0 +1,77 @@
+package core
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/recallsong/sogw/sogw/proxy/router"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestRoutersFind(t *testing.T) {
+	rs := NewRouters()
+	rs.Global.Add("GET", "/api/users", "global")
+	rs.Global.Add("POST", "/api/orders", "global-orders")
+	rs.Hosts["h1"] = router.New()
+	rs.Hosts["h1"].Add("GET", "/api/users", "h1")
+	rs.Hosts["h1"].Add("GET", "/api/orders", "h1-orders")
+
+	h1 := NewHost(&meta.Host{Id: "h1", Value: "a.example.com"})
+	h2 := NewHost(&meta.Host{Id: "h2", Value: "b.example.com"})
+
+	result, ok := rs.Find(h1, "GET", "/api/users")
+	assert.True(t, ok)
+	assert.Equal(t, "h1", result.Dest)
+
+	result, ok = rs.Find(h2, "GET", "/api/users")
+	assert.True(t, ok)
+	assert.Equal(t, "global", result.Dest)
+
+	result, ok = rs.Find(nil, "GET", "/api/users")
+	assert.True(t, ok)
+	assert.Equal(t, "global", result.Dest)
+
+	result, ok = rs.Find(h1, "POST", "/api/orders")
+	assert.True(t, ok)
+	assert.Equal(t, "global-orders", result.Dest)
+
+	_, ok = rs.Find(h1, "GET", "/api/none")
+	assert.False(t, ok)
+}
+
+func TestRouteSplit(t *testing.T) {
+	ser := NewService(&meta.Service{Id: "s1", Name: "s1"})
+	ser.Init(nil)
+	ser.Apis["v1"] = NewApi(&meta.Api{Id: "v1"}, ser)
+	ser.Apis["v2"] = NewApi(&meta.Api{Id: "v2"}, ser)
+	route := NewRoute(&meta.Route{Id: "r1", Service: "s1", ApiId: "v1", Split: &meta.TrafficSplit{
+		Apis:   []*meta.WeightedApi{{ApiId: "v1", Weight: 95}, {ApiId: "v2", Weight: 5}, {ApiId: "v3"}},
+		Cookie: "uid",
+	}})
+	newCtx := func(uid string) *RequestContext {
+		ctx := NewRequestContext(&fasthttp.RequestCtx{})
+		ctx.Services = map[string]*Service{"s1": ser}
+		if len(uid) > 0 {
+			ctx.ReqCtx.Request.Header.SetCookie("uid", uid)
+		}
+		return ctx
+	}
+	counts := make(map[string]int)
+	for i := 0; i < 1000; i++ {
+		counts[route.Dispatch(newCtx("")).Meta.Id]++
+	}
+	assert.Equal(t, 2, len(counts))
+	assert.True(t, counts["v1"] > counts["v2"])
+	for i := 0; i < 100; i++ {
+		uid := strconv.Itoa(i)
+		assert.Equal(t, route.Dispatch(newCtx(uid)), route.Dispatch(newCtx(uid)))
+	}
+
+	assert.Nil(t, NewTrafficSplit(&meta.TrafficSplit{Apis: []*meta.WeightedApi{{ApiId: "v1"}}}))
+	route = NewRoute(&meta.Route{Id: "r1", Service: "s1", ApiConds: []*meta.ApiCondition{{
+		Matcher: &meta.Matcher{}, ApiId: "v2",
+	}}})
+	assert.Equal(t, 1, len(route.ApiConds))
+}
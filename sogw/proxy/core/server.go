@@ -1,42 +1,120 @@
 package core
 
 import (
+	"crypto/tls"
+	"net"
+	"time"
+
 	"github.com/recallsong/go-utils/lang"
 	"github.com/recallsong/go-utils/reflectx"
 	"github.com/recallsong/sogw/store/meta"
 	log "github.com/sirupsen/logrus"
 	"github.com/valyala/fasthttp"
+	"golang.org/x/net/http2"
 )
 
 type Server struct {
 	_              lang.NoCopy
 	Meta           *meta.Server
-	client         *fasthttp.Client
+	upstream       *upstreamClient
+	err            error
 	checkFailTimes int64
 	checkSum       int64
 	// healthCk       *HealthChecker
 }
 
 func NewServer(m *meta.Server) *Server {
-	svr := &Server{
-		Meta:   m,
-		client: httpClient,
+	return newServer(m, m.Client)
+}
+
+func newServer(m *meta.Server, c *meta.UpstreamClient) *Server {
+	svr := &Server{Meta: m}
+	svr.upstream, svr.err = getUpstreamClient(m, c)
+	if svr.err != nil {
+		log.Errorf("[server] invalid client config of server %s : %s", m.Addr, svr.err)
+		svr.upstream = defaultUpstream
 	}
 	return svr
 }
 
 func (s *Server) Forward(freq *fasthttp.Request, fresp *fasthttp.Response) error {
-	freq.SetHost(s.Meta.Addr)
-	if len(s.Meta.Host) > 0 {
-		freq.Header.SetHost(s.Meta.Host)
+	return s.ForwardDeadline(freq, fresp, time.Time{})
+}
+
+// ForwardDeadline 转发请求，deadline不为零值时，请求在deadline之前没有完成则返回超时错误
+func (s *Server) ForwardDeadline(freq *fasthttp.Request, fresp *fasthttp.Response, deadline time.Time) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.setHost(freq)
+	var err error
+	switch {
+	case s.Meta.Protocol != meta.ServerProtocol_HTTP1:
+		err = s.forwardHttp2(freq, fresp, deadline)
+	case deadline.IsZero():
+		err = s.upstream.client.Do(freq, fresp)
+	default:
+		err = s.upstream.client.DoDeadline(freq, fresp, deadline)
 	}
-	err := s.client.Do(freq, fresp)
 	if err != nil {
 		log.Errorf("[server] forward %s -> %s", reflectx.BytesToString(freq.URI().FullURI()), err.Error())
 	}
 	return err
 }
 
+// Dial 建立到服务器的独占连接，并设置转发请求的host，用于WebSocket等协议升级的请求
+func (s *Server) Dial(freq *fasthttp.Request, timeout time.Duration) (net.Conn, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.setHost(freq)
+	if s.upstream.tls != nil {
+		return tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", s.Meta.Addr, s.upstream.tls)
+	}
+	return net.DialTimeout("tcp", s.Meta.Addr, timeout)
+}
+
+// Http2Transport 返回服务器的HTTP/2连接池以及scheme，用于gRPC转发，服务器使用明文HTTP/1.1时返回nil
+func (s *Server) Http2Transport() (*http2.Transport, string, error) {
+	if s.err != nil {
+		return nil, "", s.err
+	}
+	return s.upstream.h2, s.scheme(), nil
+}
+
+func (s *Server) forwardHttp2(freq *fasthttp.Request, fresp *fasthttp.Response, deadline time.Time) error {
+	host := s.Meta.Host
+	if len(host) <= 0 {
+		host = s.Meta.Addr
+	}
+	timeout := s.upstream.readTimeout
+	if !deadline.IsZero() {
+		remain := time.Until(deadline)
+		if remain <= 0 {
+			return fasthttp.ErrTimeout
+		}
+		if timeout <= 0 || remain < timeout {
+			timeout = remain
+		}
+	}
+	return forwardHttp2(s.upstream.h2, s.scheme(), s.Meta.Addr, host, timeout, freq, fresp)
+}
+
+func (s *Server) scheme() string {
+	if s.upstream.tls != nil {
+		return "https"
+	}
+	return "http"
+}
+
+func (s *Server) setHost(freq *fasthttp.Request) {
+	freq.URI().SetScheme(s.scheme())
+	freq.SetHost(s.Meta.Addr)
+	if len(s.Meta.Host) > 0 {
+		freq.Header.SetHost(s.Meta.Host)
+	}
+}
+
 func (s *Server) Check() error {
 	/*
 		freq.SetHost(s.Meta.Addr)
@@ -55,5 +133,6 @@ func (s *Server) Close() error {
 	// if s.healthCk != nil {
 	// 	s.healthCk.Stop()
 	// }
+	releaseUpstreamClient(s.Meta.Id, s.upstream)
 	return nil
 }
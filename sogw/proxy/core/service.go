@@ -15,6 +15,8 @@ type Service struct {
 	Servers    map[string]*Server
 	ServerList []*Server
 	LB         LoadBalance
+	// method:path -> version -> api
+	Versions map[string]map[string]*Api
 }
 
 func NewService(m *meta.Service) *Service {
@@ -39,6 +41,15 @@ func (s *Service) Init(m *meta.ServiceConfig) *Server {
 	return nil
 }
 
+// NewServer 创建服务下的服务器，服务器没有配置客户端时使用服务配置中的客户端
+func (s *Service) NewServer(m *meta.Server) *Server {
+	c := m.Client
+	if c == nil && s.Config != nil {
+		c = s.Config.Client
+	}
+	return newServer(m, c)
+}
+
 func (s *Service) Close() error {
 	errs := errorx.Errors{}
 	for _, svr := range s.Servers {
@@ -0,0 +1,127 @@
+package core
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/valyala/fasthttp"
+)
+
+const staticIndexFile = "index.html"
+
+// staticHandlers 目录和路径前缀相同的路由共享文件处理器，fasthttp.FS的处理器会启动缓存清理协程，
+// 路由在每次配置更新时重建，所以处理器不随路由创建，没有路由使用的处理器由SweepStaticFiles释放
+var staticHandlers sync.Map
+
+type staticHandler struct {
+	handler fasthttp.RequestHandler
+	stop    chan struct{}
+}
+
+// StaticFiles 路由配置了files时由网关直接提供目录中的静态文件，
+// 支持index文件、单页应用回退到index.html、Range请求以及ETag/Last-Modified缓存。
+// 不启用fasthttp.FS的压缩，它会在目录中写入*.fasthttp.gz等缓存文件，响应由网关的compression配置压缩
+type StaticFiles struct {
+	key     string
+	prefix  string
+	handler fasthttp.RequestHandler
+}
+
+// NewStaticFiles 路由路径中第一个参数之前的部分作为前缀，查找文件时去掉该前缀，如路由 /app/* 的请求 /app/js/main.js 对应文件 js/main.js
+func NewStaticFiles(root, routePath string) *StaticFiles {
+	prefix := staticPrefix(routePath)
+	key := root + "|" + prefix
+	if h, ok := staticHandlers.Load(key); ok {
+		return &StaticFiles{key: key, prefix: prefix, handler: h.(*staticHandler).handler}
+	}
+	fs := &fasthttp.FS{
+		Root:            root,
+		IndexNames:      []string{staticIndexFile},
+		AcceptByteRange: true,
+		CleanStop:       make(chan struct{}),
+		PathNotFound: func(c *fasthttp.RequestCtx) {
+			c.SetStatusCode(fasthttp.StatusNotFound)
+		},
+	}
+	if len(prefix) > 0 {
+		fs.PathRewrite = fasthttp.NewPathPrefixStripper(len(prefix))
+	}
+	h, loaded := staticHandlers.LoadOrStore(key, &staticHandler{handler: fs.NewRequestHandler(), stop: fs.CleanStop})
+	if loaded {
+		close(fs.CleanStop)
+	}
+	return &StaticFiles{key: key, prefix: prefix, handler: h.(*staticHandler).handler}
+}
+
+// SweepStaticFiles 路由重建后释放不再被使用的文件处理器，旧路由上正在处理的请求仍然可以完成
+func SweepStaticFiles(used map[string]*StaticFiles) {
+	staticHandlers.Range(func(key, value interface{}) bool {
+		if _, ok := used[key.(string)]; !ok {
+			staticHandlers.Delete(key)
+			close(value.(*staticHandler).stop)
+		}
+		return true
+	})
+}
+
+func staticPrefix(routePath string) string {
+	idx := strings.IndexAny(routePath, ":*{")
+	if idx < 0 {
+		return ""
+	}
+	return strings.TrimSuffix(routePath[:strings.LastIndexByte(routePath[:idx], '/')+1], "/")
+}
+
+// Serve 文件不存在且路径的最后一段没有扩展名时返回index.html，由前端路由处理
+func (s *StaticFiles) Serve(ctx *RequestContext) {
+	reqc := ctx.ReqCtx
+	s.handler(reqc)
+	if reqc.Response.StatusCode() == fasthttp.StatusNotFound && isSPAPath(reqc.Path()) {
+		uri := reqc.Request.URI()
+		path := append([]byte(nil), uri.Path()...)
+		uri.SetPath(s.prefix + "/" + staticIndexFile)
+		reqc.Response.Reset()
+		s.handler(reqc)
+		uri.SetPathBytes(path)
+	}
+	resp := &reqc.Response
+	switch resp.StatusCode() {
+	case fasthttp.StatusNotFound:
+		ctx.WriteError(fasthttp.StatusNotFound)
+	case fasthttp.StatusOK, fasthttp.StatusPartialContent, fasthttp.StatusNotModified:
+		setStaticETag(&reqc.Request, resp)
+	}
+}
+
+// Key 文件处理器的标识
+func (s *StaticFiles) Key() string {
+	return s.key
+}
+
+func isSPAPath(path []byte) bool {
+	return !bytes.ContainsRune(path[bytes.LastIndexByte(path, '/')+1:], '.')
+}
+
+// setStaticETag 根据文件的修改时间生成弱ETag，If-None-Match匹配时返回304
+func setStaticETag(req *fasthttp.Request, resp *fasthttp.Response) {
+	lastModified, err := fasthttp.ParseHTTPDate(resp.Header.Peek(fasthttp.HeaderLastModified))
+	if err != nil {
+		return
+	}
+	etag := `W/"` + strconv.FormatInt(lastModified.Unix(), 16) + `"`
+	resp.Header.Set(fasthttp.HeaderETag, etag)
+	match := req.Header.Peek(fasthttp.HeaderIfNoneMatch)
+	if len(match) <= 0 || resp.StatusCode() != fasthttp.StatusOK {
+		return
+	}
+	for _, tag := range strings.Split(string(match), ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag[2:] {
+			resp.ResetBody()
+			resp.SetStatusCode(fasthttp.StatusNotModified)
+			return
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestStaticFiles(t *testing.T) {
+	assert.Equal(t, "/app", staticPrefix("/app/*"))
+	assert.Equal(t, "", staticPrefix("/*"))
+	assert.Equal(t, "", staticPrefix("/favicon.ico"))
+	assert.Equal(t, "/app", staticPrefix("/app/:name/*"))
+
+	root, err := ioutil.TempDir("", "static")
+	assert.Nil(t, err)
+	defer os.RemoveAll(root)
+	assert.Nil(t, os.MkdirAll(filepath.Join(root, "js"), 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(root, "index.html"), []byte("<html>index</html>"), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(root, "js", "main.js"), []byte("console.log(1)"), 0644))
+
+	s := NewStaticFiles(root, "/app/*")
+	serve := func(path string, header ...string) *RequestContext {
+		req := &fasthttp.Request{}
+		req.SetRequestURI(path)
+		for i := 0; i+1 < len(header); i += 2 {
+			req.Header.Set(header[i], header[i+1])
+		}
+		reqc := &fasthttp.RequestCtx{}
+		reqc.Init(req, nil, nil)
+		ctx := NewRequestContext(reqc)
+		s.Serve(ctx)
+		return ctx
+	}
+	ctx := serve("/app/js/main.js")
+	resp := &ctx.ReqCtx.Response
+	assert.Equal(t, fasthttp.StatusOK, resp.StatusCode())
+	assert.Equal(t, "console.log(1)", string(resp.Body()))
+	etag := string(resp.Header.Peek(fasthttp.HeaderETag))
+	assert.NotEmpty(t, etag)
+
+	ctx = serve("/app/js/main.js", fasthttp.HeaderIfNoneMatch, etag)
+	assert.Equal(t, fasthttp.StatusNotModified, ctx.ReqCtx.Response.StatusCode())
+
+	ctx = serve("/app/js/main.js", fasthttp.HeaderRange, "bytes=0-6")
+	assert.Equal(t, fasthttp.StatusPartialContent, ctx.ReqCtx.Response.StatusCode())
+	assert.Equal(t, "console", string(ctx.ReqCtx.Response.Body()))
+
+	ctx = serve("/app/")
+	assert.Equal(t, "<html>index</html>", string(ctx.ReqCtx.Response.Body()))
+
+	ctx = serve("/app/users/1")
+	assert.Equal(t, fasthttp.StatusOK, ctx.ReqCtx.Response.StatusCode())
+	assert.Equal(t, "<html>index</html>", string(ctx.ReqCtx.Response.Body()))
+	assert.Equal(t, "/app/users/1", string(ctx.ReqCtx.Path()))
+
+	ctx = serve("/app/js/none.js")
+	assert.Equal(t, fasthttp.StatusNotFound, ctx.ReqCtx.Response.StatusCode())
+
+	ctx = serve("/app/js/main.js", fasthttp.HeaderAcceptEncoding, "gzip, br")
+	assert.Equal(t, "console.log(1)", string(ctx.ReqCtx.Response.Body()))
+	files, _ := filepath.Glob(filepath.Join(root, "js", "*.fasthttp.*"))
+	assert.Empty(t, files)
+
+	SweepStaticFiles(map[string]*StaticFiles{s.Key(): s})
+	_, ok := staticHandlers.Load(s.Key())
+	assert.True(t, ok)
+	SweepStaticFiles(nil)
+	_, ok = staticHandlers.Load(s.Key())
+	assert.False(t, ok)
+}
@@ -0,0 +1,76 @@
+package core
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+
+	"github.com/recallsong/go-utils/lang"
+	"github.com/recallsong/sogw/store/meta"
+)
+
+// TrafficSplit 按照权重将路由的流量分配到多个api，用于灰度发布，
+// 配置了cookie或key时根据其值的哈希选择api，使同一用户的请求始终落到同一个api
+type TrafficSplit struct {
+	_      lang.NoCopy
+	apis   []string
+	bounds []uint32
+	total  uint32
+	cookie string
+	key    string
+}
+
+// NewTrafficSplit 没有可分配的权重时返回nil
+func NewTrafficSplit(m *meta.TrafficSplit) *TrafficSplit {
+	if m == nil {
+		return nil
+	}
+	s := &TrafficSplit{cookie: m.Cookie, key: m.Key}
+	for _, a := range m.Apis {
+		if a == nil || a.Weight <= 0 {
+			continue
+		}
+		s.total += uint32(a.Weight)
+		s.apis = append(s.apis, a.ApiId)
+		s.bounds = append(s.bounds, s.total)
+	}
+	if s.total <= 0 {
+		return nil
+	}
+	return s
+}
+
+// Pick 选择请求的api，没有cookie或key的值时随机选择
+func (s *TrafficSplit) Pick(ctx *RequestContext) string {
+	var n uint32
+	if v, ok := s.stickyValue(ctx); ok {
+		h := fnv.New32a()
+		h.Write([]byte(v))
+		n = h.Sum32() % s.total
+	} else {
+		n = uint32(rand.Int63n(int64(s.total)))
+	}
+	for i, b := range s.bounds {
+		if n < b {
+			return s.apis[i]
+		}
+	}
+	return s.apis[len(s.apis)-1]
+}
+
+// stickyValue key从路由的上下文中取值
+func (s *TrafficSplit) stickyValue(ctx *RequestContext) (string, bool) {
+	if len(s.cookie) > 0 {
+		v := ctx.ReqCtx.Request.Header.Cookie(s.cookie)
+		return string(v), len(v) > 0
+	}
+	if len(s.key) > 0 {
+		v := ctx.GetAttr(s.key)
+		if v == nil {
+			return "", false
+		}
+		str := fmt.Sprint(v)
+		return str, len(str) > 0
+	}
+	return "", false
+}
@@ -0,0 +1,216 @@
+package core
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/recallsong/go-utils/reflectx"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/valyala/fasthttp"
+	"golang.org/x/net/http2"
+)
+
+const DefaultUpstreamDialTimeout = 3 * time.Second
+
+// upstreamClient 连接服务器使用的客户端，同一个服务器在配置不变时共享连接池
+type upstreamClient struct {
+	client       *fasthttp.Client
+	tls          *tls.Config
+	h2           *http2.Transport
+	dialTimeout  time.Duration
+	readTimeout  time.Duration
+	writeTimeout time.Duration
+
+	key  string
+	refs int
+}
+
+var (
+	defaultUpstream = &upstreamClient{client: httpClient, dialTimeout: DefaultUpstreamDialTimeout}
+	upstreamLock    sync.Mutex
+	upstreamClients = make(map[string]*upstreamClient)
+)
+
+// getUpstreamClient 获取服务器的客户端，服务器和服务都没有配置时使用全局共享的客户端，
+// 客户端按照服务器id缓存，配置或者证书文件变化时重建，客户端配置中的超时时间单位为毫秒
+func getUpstreamClient(m *meta.Server, c *meta.UpstreamClient) (*upstreamClient, error) {
+	if c == nil && m.Protocol == meta.ServerProtocol_HTTP1 {
+		return defaultUpstream, nil
+	}
+	var serverName string
+	if m.Protocol == meta.ServerProtocol_H2 || (c != nil && c.Scheme == "https") {
+		serverName = upstreamServerName(m, c)
+	}
+	key, err := upstreamKey(m.Protocol, serverName, c)
+	if err != nil {
+		return nil, err
+	}
+	upstreamLock.Lock()
+	defer upstreamLock.Unlock()
+	uc := upstreamClients[m.Id]
+	if uc == nil || uc.key != key {
+		uc, err = newUpstreamClient(m.Protocol, serverName, c)
+		if err != nil {
+			return nil, err
+		}
+		uc.key = key
+		upstreamClients[m.Id] = uc
+	}
+	uc.refs++
+	return uc, nil
+}
+
+// releaseUpstreamClient 服务器关闭时释放客户端，没有服务器使用时关闭空闲连接
+func releaseUpstreamClient(id string, uc *upstreamClient) {
+	if uc == nil || uc == defaultUpstream {
+		return
+	}
+	upstreamLock.Lock()
+	defer upstreamLock.Unlock()
+	uc.refs--
+	if uc.refs > 0 {
+		return
+	}
+	if upstreamClients[id] == uc {
+		delete(upstreamClients, id)
+	}
+	if uc.client != httpClient {
+		uc.client.CloseIdleConnections()
+	}
+	if uc.h2 != nil {
+		uc.h2.CloseIdleConnections()
+	}
+}
+
+// upstreamKey 客户端配置的标识，证书和私钥配置为文件时包含文件的修改时间
+func upstreamKey(protocol meta.ServerProtocol, serverName string, c *meta.UpstreamClient) (string, error) {
+	key := protocol.String() + "/" + serverName
+	if c == nil {
+		return key, nil
+	}
+	data, err := c.Marshal()
+	if err != nil {
+		return "", err
+	}
+	key += "/" + reflectx.BytesToString(data)
+	for _, v := range []string{c.CaCert, c.ClientCert, c.ClientKey} {
+		if len(v) <= 0 || isPEM(v) {
+			continue
+		}
+		if info, err := os.Stat(v); err == nil {
+			key += "/" + strconv.FormatInt(info.ModTime().UnixNano(), 16)
+		}
+	}
+	return key, nil
+}
+
+func newUpstreamClient(protocol meta.ServerProtocol, serverName string, c *meta.UpstreamClient) (*upstreamClient, error) {
+	uc := &upstreamClient{dialTimeout: DefaultUpstreamDialTimeout}
+	if c != nil {
+		if c.ConnectTimeout > 0 {
+			uc.dialTimeout = time.Duration(c.ConnectTimeout) * time.Millisecond
+		}
+		uc.readTimeout = time.Duration(c.ReadTimeout) * time.Millisecond
+		uc.writeTimeout = time.Duration(c.WriteTimeout) * time.Millisecond
+	}
+	if protocol == meta.ServerProtocol_H2 || (c != nil && c.Scheme == "https") {
+		cfg, err := newUpstreamTLSConfig(serverName, c)
+		if err != nil {
+			return nil, err
+		}
+		uc.tls = cfg
+	}
+	switch {
+	case protocol == meta.ServerProtocol_H2C:
+		uc.h2 = newHttp2Transport(protocol, nil, uc.dialTimeout, uc.writeTimeout)
+	case uc.tls != nil:
+		// 启用TLS时gRPC转发也通过h2连接服务器
+		uc.h2 = newHttp2Transport(meta.ServerProtocol_H2, uc.tls, uc.dialTimeout, uc.writeTimeout)
+	}
+	if c == nil {
+		uc.client = httpClient
+		return uc, nil
+	}
+	dialTimeout := uc.dialTimeout
+	uc.client = &fasthttp.Client{
+		Dial: func(addr string) (net.Conn, error) {
+			return fasthttp.DialTimeout(addr, dialTimeout)
+		},
+		TLSConfig:           uc.tls,
+		ReadTimeout:         uc.readTimeout,
+		WriteTimeout:        uc.writeTimeout,
+		MaxConnsPerHost:     int(c.MaxConns),
+		MaxIdleConnDuration: time.Duration(c.IdleConnTimeout) * time.Millisecond,
+	}
+	return uc, nil
+}
+
+// upstreamServerName TLS的SNI，默认使用服务器的host，没有host时使用地址中的主机名
+func upstreamServerName(m *meta.Server, c *meta.UpstreamClient) string {
+	if c != nil && len(c.ServerName) > 0 {
+		return c.ServerName
+	}
+	name := m.Host
+	if len(name) <= 0 {
+		name = m.Addr
+	}
+	if host, _, err := net.SplitHostPort(name); err == nil {
+		return host
+	}
+	return name
+}
+
+func newUpstreamTLSConfig(serverName string, c *meta.UpstreamClient) (*tls.Config, error) {
+	cfg := &tls.Config{ServerName: serverName}
+	if c == nil {
+		return cfg, nil
+	}
+	cfg.InsecureSkipVerify = c.InsecureSkipVerify
+	if len(c.CaCert) > 0 {
+		ca, err := readPEM(c.CaCert)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.New("no valid certificate in upstream ca bundle")
+		}
+		cfg.RootCAs = pool
+	}
+	if len(c.ClientCert) > 0 {
+		cert, err := readPEM(c.ClientCert)
+		if err != nil {
+			return nil, err
+		}
+		key, err := readPEM(c.ClientKey)
+		if err != nil {
+			return nil, err
+		}
+		pair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates = []tls.Certificate{pair}
+	}
+	return cfg, nil
+}
+
+// readPEM 证书和私钥可以直接配置PEM内容，也可以配置网关本地的文件路径
+func readPEM(v string) ([]byte, error) {
+	if isPEM(v) {
+		return []byte(v), nil
+	}
+	return ioutil.ReadFile(v)
+}
+
+func isPEM(v string) bool {
+	return strings.HasPrefix(strings.TrimSpace(v), "-----BEGIN")
+}
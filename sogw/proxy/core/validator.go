@@ -23,8 +23,7 @@ type Validators []*Validator
 func (vs Validators) Validate(ctx *RequestContext) bool {
 	for _, v := range vs {
 		if !v.Matcher.Match(ctx) {
-			ctx.ReqCtx.SetStatusCode(int(v.Meta.Status))
-			ctx.ReqCtx.WriteString(v.Meta.ErrorMsg)
+			ctx.WriteErrorWith(int(v.Meta.Status), ErrApiValidateFailed, v.Meta.ErrorMsg)
 			return false
 		}
 	}
@@ -28,6 +28,24 @@ func (vcs ValueContexts) Get(ctx *RequestContext, name string) (string, bool) {
 
 type ValueContext map[string]*meta.ValueItem
 
+// ReadsBody 是否有从请求body中取值的配置
+func (vc ValueContext) ReadsBody() bool {
+	for _, item := range vc {
+		if item == nil {
+			continue
+		}
+		switch item.Source {
+		case meta.ValueSource_ReqFormData, meta.ValueSource_ReqJSONBody, meta.ValueSource_ReqXMLBody:
+			return true
+		case meta.ValueSource_Request:
+			if item.Name == "body" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (vc ValueContext) Get(ctx *RequestContext, name string) (string, bool) {
 	if item, ok := vc[name]; ok {
 		fn, ok := valueSourceToGetter[item.Source]
@@ -38,6 +56,14 @@ func (vc ValueContext) Get(ctx *RequestContext, name string) (string, bool) {
 	return "", false
 }
 
+// GetValue 根据ValueItem的来源获取值
+func GetValue(ctx *RequestContext, item *meta.ValueItem) (string, bool) {
+	if fn, ok := valueSourceToGetter[item.Source]; ok {
+		return fn(ctx, item.Name)
+	}
+	return "", false
+}
+
 type valueGetterFunc func(ctx *RequestContext, name string) (string, bool)
 
 var valueSourceToGetter = map[meta.ValueSource]valueGetterFunc{
@@ -126,7 +152,8 @@ func getValueFromRequest(ctx *RequestContext, name string) (string, bool) {
 	case "addr":
 		return ctx.ReqCtx.RemoteAddr().String(), true
 	case "body":
-		return reflectx.BytesToString(ctx.ReqCtx.Request.Body()), true
+		body := ctx.ReadBody()
+		return reflectx.BytesToString(body), ctx.BodyErr == nil
 	}
 	return "", false
 }
@@ -185,6 +212,9 @@ var systemFuncMap = map[string]func(ctx *RequestContext) string{
 	"now_ms()": func(ctx *RequestContext) string {
 		return strconv.FormatInt(time.Now().UnixNano()/1000000, 10)
 	},
+	"request_id()": func(ctx *RequestContext) string {
+		return ctx.RequestId
+	},
 }
 
 func getValueFromSystem(ctx *RequestContext, name string) (string, bool) {
@@ -0,0 +1,384 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/recallsong/go-utils/reflectx"
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/sogw/proxy/filters"
+	"github.com/recallsong/sogw/sogw/proxy/metrics"
+	log "github.com/sirupsen/logrus"
+	"github.com/valyala/fasthttp"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+const DefaultGrpcDialTimeout = 5 * time.Second
+
+type GrpcConfig struct {
+	// h2c listen address, http/1.1 requests are also accepted for grpc-web
+	Addr string `mapstructure:"addr"`
+	// h2 listen address with tls, format: addr,certFile,keyFile
+	TLSAddr string `mapstructure:"tls_addr"`
+	// translate grpc-web requests from browsers to grpc
+	Web         bool          `mapstructure:"web"`
+	DialTimeout time.Duration `mapstructure:"dial_timeout"`
+	// timeout of rest requests transcoded to grpc calls, 0 means no limit
+	TranscodeTimeout time.Duration `mapstructure:"transcode_timeout"`
+}
+
+// grpcServer 基于net/http的HTTP/2服务，fasthttp不支持HTTP/2
+type grpcServer struct {
+	svr      *http.Server
+	ln       net.Listener
+	certFile string
+	keyFile  string
+}
+
+func newGrpcServer(handler http.Handler, addr, certFile, keyFile string) (*grpcServer, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	svr := &http.Server{Handler: handler}
+	if len(certFile) <= 0 {
+		svr.Handler = h2c.NewHandler(handler, &http2.Server{})
+	}
+	return &grpcServer{
+		svr:      svr,
+		ln:       ln,
+		certFile: certFile,
+		keyFile:  keyFile,
+	}, nil
+}
+
+func (s *grpcServer) Serve() error {
+	if len(s.certFile) > 0 {
+		return s.svr.ServeTLS(s.ln, s.certFile, s.keyFile)
+	}
+	return s.svr.Serve(s.ln)
+}
+
+func (s *grpcServer) Close() error {
+	return s.svr.Close()
+}
+
+// grpcProxy 转发gRPC请求，路由、鉴权和负载均衡与http请求相同，服务器需要支持h2c
+type grpcProxy struct {
+	p         *HttpProxy
+	web       bool
+	transport *http2.Transport
+}
+
+func newGrpcProxy(p *HttpProxy, cfg *GrpcConfig) *grpcProxy {
+	return &grpcProxy{
+		p:         p,
+		web:       cfg.Web,
+		transport: newGrpcTransport(cfg.DialTimeout),
+	}
+}
+
+// newGrpcTransport 以h2c的方式连接服务器
+func newGrpcTransport(timeout time.Duration) *http2.Transport {
+	if timeout <= 0 {
+		timeout = DefaultGrpcDialTimeout
+	}
+	return &http2.Transport{
+		AllowHTTP: true,
+		DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+			return net.DialTimeout(network, addr, timeout)
+		},
+	}
+}
+
+// roundTripServer 通过服务器的HTTP/2连接池发送请求，服务器没有HTTP/2连接池时以h2c的方式连接
+func roundTripServer(svr *core.Server, def *http2.Transport, req *http.Request) (*http.Response, error) {
+	t, scheme, err := svr.Http2Transport()
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		t = def
+	} else {
+		req.URL.Scheme = scheme
+	}
+	return t.RoundTrip(req)
+}
+
+func (g *grpcProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	kind := grpcKindOf(r.Header.Get("Content-Type"))
+	if kind != grpcNative && !g.web {
+		kind = grpcNone
+	}
+	reqc := newGrpcRequestCtx(r)
+	ctx := g.p.newRequestContext(reqc, r.URL.Path)
+	err := g.route(ctx)
+	switch {
+	case err == filters.ErrExit:
+		// preflight of grpc-web
+		writeFastResponse(w, &reqc.Response)
+	case kind == grpcNone:
+		if err == nil {
+			err = core.ErrUnsupportedMedia
+			ctx.WriteErrorWith(fasthttp.StatusUnsupportedMediaType, err, "")
+		}
+		writeFastResponse(w, &reqc.Response)
+	case err != nil:
+		g.writeHeaders(ctx, w, kind)
+		writeGrpcError(w, kind, grpcCodeOf(reqc.Response.StatusCode()), err.Error())
+	case ctx.Server == nil:
+		// lambda api
+		err = core.ErrNotImplemented
+		ctx.WriteErrorWith(fasthttp.StatusNotImplemented, err, "")
+		g.writeHeaders(ctx, w, kind)
+		writeGrpcError(w, kind, grpcUnimplemented, "lambda api is not supported for grpc")
+	default:
+		err = g.forward(ctx, w, r, kind)
+	}
+	if err != filters.ErrExit {
+		ctx.Err = err
+	}
+	g.p.FinishRequest(ctx)
+}
+
+// route 执行路由、访问控制、鉴权，并选择服务器
+func (g *grpcProxy) route(ctx *core.RequestContext) error {
+	for _, step := range []filters.HookFunc{checkGlobalIPAcl, doRoute, checkScopedIPAcl, doForward, g.p.timeout.prepare} {
+		if err := step(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *grpcProxy) forward(ctx *core.RequestContext, w http.ResponseWriter, r *http.Request, kind grpcKind) error {
+	freq, svr := ctx.ForwardReq, ctx.Server
+	ctx.Span.Inject(&freq.Header)
+	header := make(http.Header)
+	freq.Header.VisitAll(func(k, v []byte) {
+		key := string(k)
+		if key != fasthttp.HeaderHost && key != fasthttp.HeaderContentLength {
+			header.Add(key, string(v))
+		}
+	})
+	header.Set("Te", "trailers")
+	body := r.Body
+	contentLength := r.ContentLength
+	if kind != grpcNative {
+		header.Set("Content-Type", grpcWebToGrpc(header.Get("Content-Type")))
+		if kind == grpcWebText {
+			body = newGrpcWebTextReader(r.Body)
+			contentLength = -1
+		}
+	}
+	host := reflectx.BytesToString(freq.Header.Host())
+	if len(svr.Meta.Host) > 0 {
+		host = svr.Meta.Host
+	}
+	outreq, err := http.NewRequest(r.Method, "http://"+svr.Meta.Addr+string(freq.URI().RequestURI()), body)
+	if err != nil {
+		return err
+	}
+	rctx := r.Context()
+	if !ctx.Deadline.IsZero() {
+		var cancel context.CancelFunc
+		rctx, cancel = context.WithDeadline(rctx, ctx.Deadline)
+		defer cancel()
+	}
+	outreq = outreq.WithContext(rctx)
+	outreq.Header = header
+	outreq.Host = host
+	outreq.ContentLength = contentLength
+
+	service, server := ctx.Service.Meta.Name, svr.Meta.Addr
+	inflight := metrics.RequestsInFlight.WithLabelValues(service, server)
+	inflight.Inc()
+	start := time.Now()
+	resp, err := roundTripServer(svr, g.transport, outreq)
+	ctx.UpstreamCost = time.Since(start)
+	inflight.Dec()
+	if err != nil {
+		metrics.BackendErrors.WithLabelValues(service, server).Inc()
+		log.Errorf("[grpc] [%s] forward to %s error : %v", ctx.RequestId, server, err)
+		if core.IsTimeout(err) {
+			ctx.WriteErrorWith(fasthttp.StatusGatewayTimeout, core.ErrGatewayTimeout, "")
+			g.writeHeaders(ctx, w, kind)
+			writeGrpcError(w, kind, grpcDeadlineExceeded, "upstream timeout")
+			return core.ErrGatewayTimeout
+		}
+		ctx.WriteErrorWith(fasthttp.StatusBadGateway, core.ErrServiceUnavailable, "")
+		g.writeHeaders(ctx, w, kind)
+		writeGrpcError(w, kind, grpcUnavailable, "upstream unavailable")
+		return err
+	}
+	defer resp.Body.Close()
+	ctx.ReqCtx.Response.SetStatusCode(resp.StatusCode)
+
+	g.writeHeaders(ctx, w, kind)
+	h := w.Header()
+	for k, vv := range resp.Header {
+		switch k {
+		case "Connection", "Keep-Alive", "Transfer-Encoding", "Trailer", "Content-Length":
+			continue
+		}
+		h[k] = vv
+	}
+	var out io.Writer = w
+	var text *grpcWebTextWriter
+	if kind != grpcNative {
+		h.Set("Content-Type", grpcToGrpcWeb(h.Get("Content-Type"), kind))
+		if kind == grpcWebText {
+			text = newGrpcWebTextWriter(w)
+			out = text
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, err = copyAndFlush(out, w, resp.Body)
+	if err != nil {
+		log.Errorf("[grpc] [%s] stream from %s error : %v", ctx.RequestId, server, err)
+		return err
+	}
+	if kind == grpcNative {
+		for k, vv := range resp.Trailer {
+			h[http.TrailerPrefix+k] = vv
+		}
+		return nil
+	}
+	if len(resp.Trailer) > 0 {
+		out.Write(grpcWebTrailerFrame(resp.Trailer))
+	}
+	if text != nil {
+		text.Close()
+	}
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+// writeHeaders 设置请求id以及跨域相关的响应头
+func (g *grpcProxy) writeHeaders(ctx *core.RequestContext, w http.ResponseWriter, kind grpcKind) {
+	h := w.Header()
+	h.Set(g.p.cfg.RequestIdHeader, ctx.RequestId)
+	ctx.SetCorsHeaders()
+	resp := &ctx.ReqCtx.Response
+	for _, name := range []string{"Access-Control-Allow-Origin", "Access-Control-Allow-Credentials", "Access-Control-Expose-Headers", "Vary"} {
+		if v := resp.Header.Peek(name); len(v) > 0 {
+			h.Set(name, string(v))
+		}
+	}
+	if kind != grpcNative && len(h.Get("Access-Control-Allow-Origin")) > 0 {
+		expose := "grpc-status, grpc-message"
+		if v := h.Get("Access-Control-Expose-Headers"); len(v) > 0 {
+			expose = v + ", " + expose
+		}
+		h.Set("Access-Control-Expose-Headers", expose)
+	}
+}
+
+// copyAndFlush 复制数据，每次读取后立即刷新，保证流式调用的实时性
+func copyAndFlush(dst io.Writer, w http.ResponseWriter, src io.Reader) (int64, error) {
+	flusher, _ := w.(http.Flusher)
+	buf := make([]byte, 32*1024)
+	var total int64
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			total += int64(n)
+			if _, werr := dst.Write(buf[:n]); werr != nil {
+				return total, werr
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+		if err == io.EOF {
+			return total, nil
+		} else if err != nil {
+			return total, err
+		}
+	}
+}
+
+// newGrpcRequestCtx 将net/http的请求头部转换为fasthttp请求，用于复用路由等处理，不包含body
+func newGrpcRequestCtx(r *http.Request) *fasthttp.RequestCtx {
+	var req fasthttp.Request
+	req.Header.SetMethod(r.Method)
+	req.SetRequestURI(r.URL.RequestURI())
+	req.Header.SetHost(r.Host)
+	for k, vv := range r.Header {
+		if k == fasthttp.HeaderContentLength {
+			continue
+		}
+		for _, v := range vv {
+			req.Header.Add(k, v)
+		}
+	}
+	reqc := &fasthttp.RequestCtx{}
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		reqc.Init(&req, addr, nil)
+	} else {
+		reqc.Init(&req, nil, nil)
+	}
+	return reqc
+}
+
+// writeFastResponse 将fasthttp的响应输出到net/http，用于预检和错误响应
+func writeFastResponse(w http.ResponseWriter, resp *fasthttp.Response) {
+	h := w.Header()
+	resp.Header.VisitAll(func(k, v []byte) {
+		key := string(k)
+		switch key {
+		case fasthttp.HeaderContentLength, fasthttp.HeaderConnection, fasthttp.HeaderServer, fasthttp.HeaderDate:
+			return
+		}
+		h.Add(key, string(v))
+	})
+	w.WriteHeader(resp.StatusCode())
+	w.Write(resp.Body())
+}
+
+func (p *HttpProxy) initGrpcServers(c *GrpcConfig) error {
+	if c.Addr == "" && c.TLSAddr == "" {
+		return nil
+	}
+	handler := newGrpcProxy(p, c)
+	if c.Addr != "" {
+		svr, err := newGrpcServer(handler, c.Addr, "", "")
+		if err != nil {
+			log.Errorf("[proxy] %v", err)
+			return err
+		}
+		if err = p.svrGrp.Put(c.Addr, svr); err != nil {
+			log.Errorf("[proxy] %v", err)
+			return err
+		}
+		log.Infof("[proxy] listen grpc (h2c) [ %s ] ok, grpc-web : %v", c.Addr, c.Web)
+	}
+	if c.TLSAddr != "" {
+		parts := strings.Split(c.TLSAddr, ",")
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			err := fmt.Errorf("grpc tls address format is invalid")
+			log.Error("[proxy] ", err)
+			return err
+		}
+		svr, err := newGrpcServer(handler, parts[0], parts[1], parts[2])
+		if err != nil {
+			log.Errorf("[proxy] %v", err)
+			return err
+		}
+		if err = p.svrGrp.Put(parts[0], svr); err != nil {
+			log.Errorf("[proxy] %v", err)
+			return err
+		}
+		log.Infof("[proxy] listen grpc (h2) [ %s ] ok, grpc-web : %v", parts[0], c.Web)
+	}
+	return nil
+}
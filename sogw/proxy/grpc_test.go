@@ -0,0 +1,81 @@
+package proxy
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func newGrpcBackend(t *testing.T) *httptest.Server {
+	return httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/grpc+proto", r.Header.Get("Content-Type"))
+		assert.Equal(t, "trailers", r.Header.Get("Te"))
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/grpc+proto")
+		w.WriteHeader(http.StatusOK)
+		w.Write(body)
+		w.Header().Set(http.TrailerPrefix+"Grpc-Status", "0")
+	}), &http2.Server{}))
+}
+
+func newGrpcTestContext(addr string) *core.RequestContext {
+	ctx := core.NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.RequestId = "req1"
+	ctx.Service = &core.Service{Meta: &meta.Service{Name: "greeter"}}
+	ctx.Server = core.NewServer(&meta.Server{Addr: addr})
+	ctx.ForwardReq = &fasthttp.Request{}
+	ctx.ForwardReq.SetRequestURI("/helloworld.Greeter/SayHello")
+	ctx.ForwardReq.Header.SetHost("example.com")
+	return ctx
+}
+
+func TestGrpcForward(t *testing.T) {
+	backend := newGrpcBackend(t)
+	defer backend.Close()
+	addr := strings.TrimPrefix(backend.URL, "http://")
+	g := newGrpcProxy(&HttpProxy{cfg: &Config{RequestIdHeader: "X-Request-Id"}, timeout: newRequestTimeout(&TimeoutConfig{})}, &GrpcConfig{Web: true})
+	msg := "\x00\x00\x00\x00\x03abc"
+
+	ctx := newGrpcTestContext(addr)
+	ctx.ForwardReq.Header.SetContentType("application/grpc+proto")
+	r := httptest.NewRequest("POST", "/helloworld.Greeter/SayHello", strings.NewReader(msg))
+	w := httptest.NewRecorder()
+	assert.Nil(t, g.forward(ctx, w, r, grpcNative))
+	res := w.Result()
+	assert.Equal(t, "application/grpc+proto", res.Header.Get("Content-Type"))
+	assert.Equal(t, "req1", res.Header.Get("X-Request-Id"))
+	assert.Equal(t, msg, w.Body.String())
+	assert.Equal(t, "0", res.Trailer.Get("Grpc-Status"))
+
+	ctx = newGrpcTestContext(addr)
+	ctx.ForwardReq.Header.SetContentType("application/grpc-web-text+proto")
+	r = httptest.NewRequest("POST", "/helloworld.Greeter/SayHello", strings.NewReader(base64.StdEncoding.EncodeToString([]byte(msg))))
+	w = httptest.NewRecorder()
+	assert.Nil(t, g.forward(ctx, w, r, grpcWebText))
+	assert.Equal(t, "application/grpc-web-text+proto", w.Header().Get("Content-Type"))
+	body, err := base64.StdEncoding.DecodeString(w.Body.String())
+	assert.Nil(t, err)
+	assert.Equal(t, msg+"\x80\x00\x00\x00\x10grpc-status: 0\r\n", string(body))
+}
+
+func TestGrpcHelpers(t *testing.T) {
+	assert.Equal(t, grpcNative, grpcKindOf("application/grpc"))
+	assert.Equal(t, grpcWeb, grpcKindOf("application/grpc-web+proto"))
+	assert.Equal(t, grpcWebText, grpcKindOf("application/grpc-web-text"))
+	assert.Equal(t, grpcNone, grpcKindOf("application/json"))
+	assert.Equal(t, "application/grpc+json", grpcWebToGrpc("application/grpc-web+json"))
+	assert.Equal(t, "application/grpc-web+proto", grpcToGrpcWeb("application/grpc+proto", grpcWeb))
+	assert.Equal(t, grpcUnimplemented, grpcCodeOf(http.StatusNotFound))
+	assert.Equal(t, grpcUnavailable, grpcCodeOf(http.StatusServiceUnavailable))
+	assert.Equal(t, "100%25 done%0A", encodeGrpcMessage("100% done\n"))
+}
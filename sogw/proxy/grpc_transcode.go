@@ -0,0 +1,310 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/recallsong/go-utils/reflectx"
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/sogw/proxy/metrics"
+	log "github.com/sirupsen/logrus"
+	"github.com/valyala/fasthttp"
+	"golang.org/x/net/http2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+var errMalformedGrpcResponse = errors.New("malformed grpc response")
+
+// grpcStatus gRPC调用的状态，调用失败时作为JSON响应返回给客户端
+type grpcStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// grpcTranscoder 按照api的grpc配置将JSON请求转换为一元gRPC调用，并将响应转换为JSON，
+// 请求消息与body、路径参数、查询参数的对应关系同 google.api.http
+type grpcTranscoder struct {
+	timeout   time.Duration
+	transport *http2.Transport
+}
+
+func newGrpcTranscoder(cfg *GrpcConfig) *grpcTranscoder {
+	return &grpcTranscoder{
+		timeout:   cfg.TranscodeTimeout,
+		transport: newGrpcTransport(cfg.DialTimeout),
+	}
+}
+
+func (t *grpcTranscoder) Start(ctx *core.RequestContext) error {
+	g := ctx.Api.Meta.Grpc
+	if g == nil || ctx.ForwardResp != nil || ctx.Server == nil {
+		return nil
+	}
+	md, err := ctx.Descriptors.FindMethod(g.DescriptorName, g.Method)
+	if err == nil && (md.IsStreamingClient() || md.IsStreamingServer()) {
+		err = fmt.Errorf("streaming grpc method %s is not supported", g.Method)
+	}
+	if err != nil {
+		log.Errorf("[grpc] [%s] api %s : %v", ctx.RequestId, ctx.Api.Meta.Id, err)
+		ctx.WriteErrorWith(fasthttp.StatusNotImplemented, core.ErrNotImplemented, "")
+		return core.ErrNotImplemented
+	}
+	in := dynamicpb.NewMessage(md.Input())
+	if err = transcodeRequest(ctx, in, g.Body); err != nil {
+		ctx.WriteErrorWith(fasthttp.StatusBadRequest, core.ErrInvalidBody, err.Error())
+		return core.ErrInvalidBody
+	}
+	service, server := ctx.Service.Meta.Name, ctx.Server.Meta.Addr
+	inflight := metrics.RequestsInFlight.WithLabelValues(service, server)
+	inflight.Inc()
+	start := time.Now()
+	data, status, err := t.invoke(ctx, md, in)
+	ctx.UpstreamCost = time.Since(start)
+	inflight.Dec()
+	var body []byte
+	if err == nil {
+		if status.Code != grpcOK {
+			body, err = json.Marshal(status)
+		} else {
+			out := dynamicpb.NewMessage(md.Output())
+			if err = proto.Unmarshal(data, out); err == nil {
+				body, err = protojson.Marshal(out)
+			}
+		}
+	}
+	if err != nil {
+		metrics.BackendErrors.WithLabelValues(service, server).Inc()
+		log.Errorf("[grpc] [%s] transcode to %s error : %v", ctx.RequestId, server, err)
+		if core.IsTimeout(err) {
+			ctx.WriteErrorWith(fasthttp.StatusGatewayTimeout, core.ErrGatewayTimeout, "")
+			return core.ErrGatewayTimeout
+		}
+		ctx.WriteError(fasthttp.StatusBadGateway)
+		return err
+	}
+	fresp := fasthttp.AcquireResponse()
+	fresp.SetStatusCode(httpStatusOf(status.Code))
+	fresp.Header.SetContentType("application/json")
+	fresp.SetBody(body)
+	ctx.ForwardResp = fresp
+	return nil
+}
+
+func (t *grpcTranscoder) End(ctx *core.RequestContext) error {
+	return nil
+}
+
+// invoke 发送一元gRPC调用，调用成功时返回响应消息的编码
+func (t *grpcTranscoder) invoke(ctx *core.RequestContext, md protoreflect.MethodDescriptor, in proto.Message) ([]byte, *grpcStatus, error) {
+	data, err := proto.Marshal(in)
+	if err != nil {
+		return nil, nil, err
+	}
+	frame := make([]byte, 5, 5+len(data))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(data)))
+	frame = append(frame, data...)
+
+	freq, svr := ctx.ForwardReq, ctx.Server
+	ctx.Span.Inject(&freq.Header)
+	header := make(http.Header)
+	freq.Header.VisitAll(func(k, v []byte) {
+		switch key := string(k); key {
+		case fasthttp.HeaderHost, fasthttp.HeaderContentLength, fasthttp.HeaderContentType,
+			fasthttp.HeaderContentEncoding, fasthttp.HeaderAcceptEncoding, fasthttp.HeaderAccept:
+		default:
+			header.Add(key, string(v))
+		}
+	})
+	header.Set("Content-Type", grpcContentType+"+proto")
+	header.Set("Te", "trailers")
+	host := reflectx.BytesToString(freq.Header.Host())
+	if len(svr.Meta.Host) > 0 {
+		host = svr.Meta.Host
+	}
+	path := "/" + string(md.Parent().FullName()) + "/" + string(md.Name())
+	outreq, err := http.NewRequest(http.MethodPost, "http://"+svr.Meta.Addr+path, bytes.NewReader(frame))
+	if err != nil {
+		return nil, nil, err
+	}
+	timeout := t.timeout
+	if !ctx.Deadline.IsZero() {
+		if remain := time.Until(ctx.Deadline); timeout <= 0 || remain < timeout {
+			timeout = remain
+		}
+	}
+	if timeout < 0 || (timeout == 0 && !ctx.Deadline.IsZero()) {
+		return nil, nil, context.DeadlineExceeded
+	}
+	if timeout > 0 {
+		c, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		outreq = outreq.WithContext(c)
+		ms := (timeout + time.Millisecond - 1) / time.Millisecond
+		header.Set("Grpc-Timeout", strconv.FormatInt(int64(ms), 10)+"m")
+	}
+	outreq.Header = header
+	outreq.Host = host
+	resp, err := roundTripServer(svr, t.transport, outreq)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	status := grpcStatusOf(resp)
+	if status.Code != grpcOK {
+		return nil, status, nil
+	}
+	msg, err := readGrpcFrame(body)
+	return msg, status, err
+}
+
+// grpcStatusOf 从trailers中读取gRPC状态，Trailers-Only的响应从头部读取
+func grpcStatusOf(resp *http.Response) *grpcStatus {
+	if resp.StatusCode != http.StatusOK {
+		return &grpcStatus{Code: grpcCodeOf(resp.StatusCode), Message: http.StatusText(resp.StatusCode)}
+	}
+	code, msg := resp.Trailer.Get("Grpc-Status"), resp.Trailer.Get("Grpc-Message")
+	if len(code) <= 0 {
+		code, msg = resp.Header.Get("Grpc-Status"), resp.Header.Get("Grpc-Message")
+	}
+	c, err := strconv.Atoi(code)
+	if err != nil {
+		return &grpcStatus{Code: grpcUnknown, Message: "invalid grpc-status " + code}
+	}
+	return &grpcStatus{Code: c, Message: decodeGrpcMessage(msg)}
+}
+
+// readGrpcFrame 读取一元调用响应中的消息，不支持压缩的消息
+func readGrpcFrame(body []byte) ([]byte, error) {
+	if len(body) < 5 || body[0]&1 != 0 {
+		return nil, errMalformedGrpcResponse
+	}
+	n := binary.BigEndian.Uint32(body[1:5])
+	if uint32(len(body)-5) < n {
+		return nil, errMalformedGrpcResponse
+	}
+	return body[5 : 5+n], nil
+}
+
+// transcodeRequest 填充请求消息：body为"*"时整个body对应请求消息，为字段名时body对应该字段，
+// 路径参数覆盖同名字段，body不为"*"时其余字段从查询参数中获取
+func transcodeRequest(ctx *core.RequestContext, msg *dynamicpb.Message, body string) error {
+	req := &ctx.ReqCtx.Request
+	if data := req.Body(); len(body) > 0 && len(bytes.TrimSpace(data)) > 0 {
+		var target proto.Message = msg
+		if body != "*" {
+			fd := msg.Descriptor().Fields().ByName(protoreflect.Name(body))
+			if fd == nil || fd.Message() == nil || fd.IsList() || fd.IsMap() {
+				return fmt.Errorf("invalid grpc body field %s", body)
+			}
+			target = msg.Mutable(fd).Message().Interface()
+		}
+		if err := protojson.Unmarshal(data, target); err != nil {
+			return err
+		}
+	}
+	for i, name := range ctx.PathNames {
+		if err := setField(msg, name, ctx.PathValues[i]); err != nil {
+			return err
+		}
+	}
+	if body == "*" {
+		return nil
+	}
+	var err error
+	req.URI().QueryArgs().VisitAll(func(k, v []byte) {
+		if err == nil {
+			err = setField(msg, string(k), string(v))
+		}
+	})
+	return err
+}
+
+// setField 按照点分隔的字段路径设置字段值，忽略未知的字段，重复字段追加值
+func setField(msg protoreflect.Message, path, value string) error {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fields := msg.Descriptor().Fields()
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = fields.ByJSONName(name)
+		}
+		if fd == nil || fd.IsMap() {
+			return nil
+		}
+		if i < len(names)-1 {
+			if fd.Message() == nil || fd.IsList() {
+				return nil
+			}
+			msg = msg.Mutable(fd).Message()
+			continue
+		}
+		v, err := parseFieldValue(fd, value)
+		if err != nil {
+			return fmt.Errorf("invalid value of field %s, %v", path, err)
+		}
+		if fd.IsList() {
+			msg.Mutable(fd).List().Append(v)
+		} else {
+			msg.Set(fd, v)
+		}
+	}
+	return nil
+}
+
+func parseFieldValue(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BytesKind:
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			b, err = base64.URLEncoding.DecodeString(s)
+		}
+		return protoreflect.ValueOfBytes(b), err
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(s)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(s, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(s, 10, 64)
+		return protoreflect.ValueOfUint64(n), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(s, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(s, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	}
+	return protoreflect.Value{}, fmt.Errorf("%s can not be set from parameter", fd.Kind())
+}
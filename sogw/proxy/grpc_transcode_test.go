@@ -0,0 +1,180 @@
+package proxy
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func newTestDescriptors(t *testing.T) *core.Descriptors {
+	field := func(name string, num int32, typ descriptorpb.FieldDescriptorProto_Type, label descriptorpb.FieldDescriptorProto_Label, typeName string) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(num),
+			Type:     typ.Enum(),
+			Label:    label.Enum(),
+		}
+		if len(typeName) > 0 {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	opt, rep := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("hello.proto"),
+		Package: proto.String("hello"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Inner"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("value", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, opt, ""),
+			},
+		}, {
+			Name: proto.String("HelloRequest"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, opt, ""),
+				field("count", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32, opt, ""),
+				field("tags", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, rep, ""),
+				field("inner", 4, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, opt, ".hello.Inner"),
+			},
+		}, {
+			Name: proto.String("HelloReply"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("message", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, opt, ""),
+			},
+		}},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("Greeter"),
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       proto.String("SayHello"),
+				InputType:  proto.String(".hello.HelloRequest"),
+				OutputType: proto.String(".hello.HelloReply"),
+			}},
+		}},
+	}
+	data, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{file}})
+	assert.Nil(t, err)
+	return core.NewDescriptors(map[string]*meta.ProtoDescriptor{
+		"hello": {Id: "hello", Name: "hello", Data: data},
+	})
+}
+
+func newTranscodeContext(descs *core.Descriptors, addr, uri, body string, grpc *meta.GrpcTranscode) *core.RequestContext {
+	ctx := core.NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.RequestId = "req1"
+	ctx.Descriptors = descs
+	ctx.ReqCtx.Request.SetRequestURI(uri)
+	ctx.ReqCtx.Request.SetBodyString(body)
+	ctx.Service = &core.Service{Meta: &meta.Service{Name: "greeter"}}
+	ctx.Api = core.NewApi(&meta.Api{Id: "api1", Grpc: grpc}, ctx.Service)
+	ctx.Server = core.NewServer(&meta.Server{Addr: addr})
+	ctx.ForwardReq = &fasthttp.Request{}
+	ctx.ForwardReq.Header.SetHost("example.com")
+	ctx.ForwardReq.Header.Set("Authorization", "token")
+	return ctx
+}
+
+func TestTranscodeRequest(t *testing.T) {
+	descs := newTestDescriptors(t)
+	md, err := descs.FindMethod("hello", "hello.Greeter/SayHello")
+	assert.Nil(t, err)
+	_, err = descs.FindMethod("hello", "hello.Greeter.SayBye")
+	assert.NotNil(t, err)
+
+	ctx := newTranscodeContext(descs, "", "/hello/tom?count=3&tags=a&tags=b&inner.value=x&other=1", "", &meta.GrpcTranscode{})
+	ctx.PathNames, ctx.PathValues = []string{"name"}, []string{"tom"}
+	msg := dynamicpb.NewMessage(md.Input())
+	assert.Nil(t, transcodeRequest(ctx, msg, ""))
+	fields := md.Input().Fields()
+	assert.Equal(t, "tom", msg.Get(fields.ByName("name")).String())
+	assert.Equal(t, int64(3), msg.Get(fields.ByName("count")).Int())
+	assert.Equal(t, 2, msg.Get(fields.ByName("tags")).List().Len())
+	inner := msg.Get(fields.ByName("inner")).Message()
+	assert.Equal(t, "x", inner.Get(inner.Descriptor().Fields().ByName("value")).String())
+
+	ctx = newTranscodeContext(descs, "", "/hello/tom?count=3", `{"count": 5, "tags": ["c"]}`, &meta.GrpcTranscode{})
+	ctx.PathNames, ctx.PathValues = []string{"name"}, []string{"tom"}
+	msg = dynamicpb.NewMessage(md.Input())
+	assert.Nil(t, transcodeRequest(ctx, msg, "*"))
+	assert.Equal(t, "tom", msg.Get(fields.ByName("name")).String())
+	assert.Equal(t, int64(5), msg.Get(fields.ByName("count")).Int())
+
+	ctx = newTranscodeContext(descs, "", "/hello?count=x", "", &meta.GrpcTranscode{})
+	assert.NotNil(t, transcodeRequest(ctx, dynamicpb.NewMessage(md.Input()), ""))
+	assert.NotNil(t, transcodeRequest(ctx, dynamicpb.NewMessage(md.Input()), "name"))
+}
+
+func TestGrpcTranscode(t *testing.T) {
+	descs := newTestDescriptors(t)
+	backend := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/hello.Greeter/SayHello", r.URL.Path)
+		assert.Equal(t, "token", r.Header.Get("Authorization"))
+		body, _ := ioutil.ReadAll(r.Body)
+		// HelloRequest{name: "tom"}
+		if string(body) != "\x00\x00\x00\x00\x05\x0a\x03tom" {
+			w.Header().Set("Content-Type", "application/grpc+proto")
+			w.Header().Set("Grpc-Status", "5")
+			w.Header().Set("Grpc-Message", "user%20not%20found")
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.Header().Set("Content-Type", "application/grpc+proto")
+		w.WriteHeader(http.StatusOK)
+		// HelloReply{message: "hi"}
+		w.Write([]byte("\x00\x00\x00\x00\x04\x0a\x02hi"))
+		w.Header().Set(http.TrailerPrefix+"Grpc-Status", "0")
+	}), &http2.Server{}))
+	defer backend.Close()
+	addr := strings.TrimPrefix(backend.URL, "http://")
+	tc := newGrpcTranscoder(&GrpcConfig{})
+	grpc := &meta.GrpcTranscode{DescriptorName: "hello", Method: "hello.Greeter/SayHello", Body: "*"}
+
+	ctx := newTranscodeContext(descs, addr, "/hello", `{"name": "tom"}`, grpc)
+	assert.Nil(t, tc.Start(ctx))
+	assert.Equal(t, fasthttp.StatusOK, ctx.ForwardResp.StatusCode())
+	assert.Equal(t, "application/json", string(ctx.ForwardResp.Header.ContentType()))
+	var reply map[string]interface{}
+	assert.Nil(t, json.Unmarshal(ctx.ForwardResp.Body(), &reply))
+	assert.Equal(t, map[string]interface{}{"message": "hi"}, reply)
+
+	ctx = newTranscodeContext(descs, addr, "/hello", `{"name": "jerry"}`, grpc)
+	assert.Nil(t, tc.Start(ctx))
+	assert.Equal(t, fasthttp.StatusNotFound, ctx.ForwardResp.StatusCode())
+	assert.JSONEq(t, `{"code": 5, "message": "user not found"}`, string(ctx.ForwardResp.Body()))
+
+	ctx = newTranscodeContext(descs, addr, "/hello", "", &meta.GrpcTranscode{DescriptorName: "hello", Method: "hello.Greeter/SayBye"})
+	assert.Equal(t, core.ErrNotImplemented, tc.Start(ctx))
+	assert.Equal(t, fasthttp.StatusNotImplemented, ctx.ReqCtx.Response.StatusCode())
+
+	ctx = newTranscodeContext(descs, addr, "/hello", `{"name": "tom"}`, grpc)
+	ctx.Deadline = time.Now().Add(-time.Millisecond)
+	assert.Equal(t, core.ErrGatewayTimeout, tc.Start(ctx))
+	assert.Equal(t, fasthttp.StatusGatewayTimeout, ctx.ReqCtx.Response.StatusCode())
+}
+
+func TestGrpcStatusHelpers(t *testing.T) {
+	assert.Equal(t, http.StatusGatewayTimeout, httpStatusOf(grpcDeadlineExceeded))
+	assert.Equal(t, http.StatusConflict, httpStatusOf(grpcAlreadyExists))
+	assert.Equal(t, http.StatusInternalServerError, httpStatusOf(grpcDataLoss))
+	_, err := readGrpcFrame([]byte("\x00\x00\x00\x00\x05abc"))
+	assert.Equal(t, errMalformedGrpcResponse, err)
+	data, err := readGrpcFrame([]byte("\x00\x00\x00\x00\x02ab"))
+	assert.Nil(t, err)
+	assert.Equal(t, "ab", string(data))
+	assert.Equal(t, "user not found", decodeGrpcMessage("user%20not%20found"))
+}
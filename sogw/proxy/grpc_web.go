@@ -0,0 +1,215 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type grpcKind int
+
+const (
+	grpcNone grpcKind = iota
+	grpcNative
+	grpcWeb
+	grpcWebText
+)
+
+const (
+	grpcContentType        = "application/grpc"
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+)
+
+// gRPC状态码
+const (
+	grpcOK                 = 0
+	grpcCanceled           = 1
+	grpcUnknown            = 2
+	grpcInvalidArgument    = 3
+	grpcDeadlineExceeded   = 4
+	grpcNotFound           = 5
+	grpcAlreadyExists      = 6
+	grpcPermissionDenied   = 7
+	grpcResourceExhausted  = 8
+	grpcFailedPrecondition = 9
+	grpcAborted            = 10
+	grpcOutOfRange         = 11
+	grpcUnimplemented      = 12
+	grpcInternal           = 13
+	grpcUnavailable        = 14
+	grpcDataLoss           = 15
+	grpcUnauthenticated    = 16
+)
+
+func grpcKindOf(contentType string) grpcKind {
+	ct := strings.ToLower(contentType)
+	switch {
+	case strings.HasPrefix(ct, grpcWebTextContentType):
+		return grpcWebText
+	case strings.HasPrefix(ct, grpcWebContentType):
+		return grpcWeb
+	case strings.HasPrefix(ct, grpcContentType):
+		return grpcNative
+	}
+	return grpcNone
+}
+
+// grpcWebToGrpc 将gRPC-Web的Content-Type转换为gRPC的，保留+proto等后缀
+func grpcWebToGrpc(contentType string) string {
+	switch grpcKindOf(contentType) {
+	case grpcWebText:
+		return grpcContentType + contentType[len(grpcWebTextContentType):]
+	case grpcWeb:
+		return grpcContentType + contentType[len(grpcWebContentType):]
+	}
+	return contentType
+}
+
+func grpcToGrpcWeb(contentType string, kind grpcKind) string {
+	if grpcKindOf(contentType) != grpcNative {
+		contentType = grpcContentType
+	}
+	if kind == grpcWebText {
+		return grpcWebTextContentType + contentType[len(grpcContentType):]
+	}
+	return grpcWebContentType + contentType[len(grpcContentType):]
+}
+
+// grpcCodeOf 按照gRPC规范将http状态码映射为gRPC状态码
+func grpcCodeOf(status int) int {
+	switch status {
+	case http.StatusBadRequest:
+		return grpcInternal
+	case http.StatusUnauthorized:
+		return grpcUnauthenticated
+	case http.StatusForbidden:
+		return grpcPermissionDenied
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return grpcUnimplemented
+	case http.StatusRequestEntityTooLarge, http.StatusTooManyRequests:
+		return grpcResourceExhausted
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return grpcUnavailable
+	}
+	return grpcUnknown
+}
+
+// httpStatusOf 将gRPC状态码映射为http状态码，用于REST到gRPC的转换
+func httpStatusOf(code int) int {
+	switch code {
+	case grpcOK:
+		return http.StatusOK
+	case grpcCanceled:
+		return 499
+	case grpcInvalidArgument, grpcFailedPrecondition, grpcOutOfRange:
+		return http.StatusBadRequest
+	case grpcDeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case grpcNotFound:
+		return http.StatusNotFound
+	case grpcAlreadyExists, grpcAborted:
+		return http.StatusConflict
+	case grpcPermissionDenied:
+		return http.StatusForbidden
+	case grpcResourceExhausted:
+		return http.StatusTooManyRequests
+	case grpcUnimplemented:
+		return http.StatusNotImplemented
+	case grpcUnavailable:
+		return http.StatusServiceUnavailable
+	case grpcUnauthenticated:
+		return http.StatusUnauthorized
+	}
+	return http.StatusInternalServerError
+}
+
+// writeGrpcError 以Trailers-Only的形式返回gRPC错误
+func writeGrpcError(w http.ResponseWriter, kind grpcKind, code int, msg string) {
+	h := w.Header()
+	switch kind {
+	case grpcWebText:
+		h.Set("Content-Type", grpcWebTextContentType+"+proto")
+	case grpcWeb:
+		h.Set("Content-Type", grpcWebContentType+"+proto")
+	default:
+		h.Set("Content-Type", grpcContentType)
+	}
+	h.Set("Grpc-Status", strconv.Itoa(code))
+	if len(msg) > 0 {
+		h.Set("Grpc-Message", encodeGrpcMessage(msg))
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// encodeGrpcMessage 按照gRPC规范对grpc-message进行百分号编码
+func encodeGrpcMessage(msg string) string {
+	var sb strings.Builder
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+		if c < ' ' || c > '~' || c == '%' {
+			fmt.Fprintf(&sb, "%%%02X", c)
+		} else {
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// decodeGrpcMessage 解码百分号编码的grpc-message，格式错误时原样返回
+func decodeGrpcMessage(msg string) string {
+	if v, err := url.PathUnescape(msg); err == nil {
+		return v
+	}
+	return msg
+}
+
+// grpcWebTrailerFrame 将trailers编码为gRPC-Web的trailer帧
+func grpcWebTrailerFrame(trailer http.Header) []byte {
+	keys := make([]string, 0, len(trailer))
+	for k := range trailer {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var buf bytes.Buffer
+	for _, k := range keys {
+		for _, v := range trailer[k] {
+			buf.WriteString(strings.ToLower(k) + ": " + v + "\r\n")
+		}
+	}
+	frame := make([]byte, 5, 5+buf.Len())
+	frame[0] = 0x80
+	binary.BigEndian.PutUint32(frame[1:], uint32(buf.Len()))
+	return append(frame, buf.Bytes()...)
+}
+
+func newGrpcWebTextReader(body io.ReadCloser) io.ReadCloser {
+	return struct {
+		io.Reader
+		io.Closer
+	}{base64.NewDecoder(base64.StdEncoding, body), body}
+}
+
+// grpcWebTextWriter 将响应以base64编码输出，不足3字节的数据在Close时输出
+type grpcWebTextWriter struct {
+	enc io.WriteCloser
+}
+
+func newGrpcWebTextWriter(w io.Writer) *grpcWebTextWriter {
+	return &grpcWebTextWriter{enc: base64.NewEncoder(base64.StdEncoding, w)}
+}
+
+func (w *grpcWebTextWriter) Write(p []byte) (int, error) {
+	return w.enc.Write(p)
+}
+
+func (w *grpcWebTextWriter) Close() error {
+	return w.enc.Close()
+}
@@ -1,29 +1,53 @@
 package proxy
 
 import (
+	"bytes"
 	"net/http"
+	"time"
 
 	"github.com/recallsong/cliframe/cobrax"
 	"github.com/recallsong/go-utils/reflectx"
 	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/sogw/proxy/filters"
+	"github.com/recallsong/sogw/sogw/proxy/metrics"
+	"github.com/recallsong/sogw/sogw/proxy/tracing"
 	"github.com/recallsong/sogw/store/meta"
 	log "github.com/sirupsen/logrus"
 	"github.com/valyala/fasthttp"
 )
 
 func (p *HttpProxy) Handler(reqc *fasthttp.RequestCtx) {
+	ctx := p.newRequestContext(reqc, "HTTP "+string(reqc.Method()))
+	if p.filters.Do(ctx) == filters.ErrExit {
+		// exit means the request has been finished by the filter, not an error
+		ctx.Err = nil
+		if ctx.Route != nil && ctx.Route.Static != nil && p.compress != nil && p.cfg.Compression.Enable {
+			// static files are served by the route step and skip the AfterForward hooks
+			p.compress.CompressFile(ctx)
+		}
+	}
+	p.FinishRequest(ctx)
+}
+
+func (p *HttpProxy) newRequestContext(reqc *fasthttp.RequestCtx, spanName string) *core.RequestContext {
 	ctx := core.NewRequestContext(reqc)
+	ctx.TrustedProxies = p.trusted
+	ctx.InitRequestId(p.cfg.RequestIdHeader)
+	ctx.Span = p.tracer.StartServerSpan(spanName, &reqc.Request.Header)
 	p.rtCtx.Lock.RLock()
-	ctx.Router = p.rtCtx.Router
+	ctx.Routers = p.rtCtx.Routers
 	ctx.Hosts = p.rtCtx.Hosts
 	ctx.Auths = p.rtCtx.Auths
 	ctx.Services = p.rtCtx.Services
+	ctx.IPAcls = p.rtCtx.IPAcls
+	ctx.Descriptors = p.rtCtx.Descriptors
 	p.rtCtx.Lock.RUnlock()
-	p.filters.Do(ctx)
-	p.FinishRequest(ctx)
+	return ctx
 }
 
 func (p *HttpProxy) FinishRequest(ctx *core.RequestContext) {
+	ctx.ReqCtx.Response.Header.Set(p.cfg.RequestIdHeader, ctx.RequestId)
+	ctx.SetCorsHeaders()
 	if ctx.Err == nil {
 		status := ctx.ReqCtx.Response.StatusCode()
 		var backend string
@@ -32,57 +56,154 @@ func (p *HttpProxy) FinishRequest(ctx *core.RequestContext) {
 		}
 		if status >= 400 {
 			if ctx.ForwardResp != nil && ctx.ForwardResp.StatusCode() >= 400 {
-				log.Errorf("[proxy] %s%s %d (by backend)", reflectx.BytesToString(ctx.ReqCtx.RequestURI()), backend, status)
+				log.Errorf("[proxy] [%s] %s%s %d (by backend)", ctx.RequestId, reflectx.BytesToString(ctx.ReqCtx.RequestURI()), backend, status)
 			} else {
-				log.Errorf("[proxy] %s%s %d", reflectx.BytesToString(ctx.ReqCtx.RequestURI()), backend, status)
+				log.Errorf("[proxy] [%s] %s%s %d", ctx.RequestId, reflectx.BytesToString(ctx.ReqCtx.RequestURI()), backend, status)
 			}
 		} else {
-			log.Infof("[proxy] %s%s %d", reflectx.BytesToString(ctx.ReqCtx.RequestURI()), backend, status)
+			log.Infof("[proxy] [%s] %s%s %d", ctx.RequestId, reflectx.BytesToString(ctx.ReqCtx.RequestURI()), backend, status)
 		}
 	} else {
-		log.Error("[proxy] ", ctx.Err)
+		log.Errorf("[proxy] [%s] %v", ctx.RequestId, ctx.Err)
 	}
+	observeRequest(ctx)
+	finishTrace(ctx)
+	p.accessLog.Log(ctx)
+	releaseUpgrade(ctx)
 	core.ReleaseRequestContext(ctx)
 }
 
+func observeRequest(ctx *core.RequestContext) {
+	var route, service, api, server string
+	if ctx.Route != nil {
+		route = ctx.Route.Meta.Id
+	}
+	if ctx.Service != nil {
+		service = ctx.Service.Meta.Name
+	}
+	if ctx.Api != nil {
+		api = ctx.Api.Meta.Id
+	}
+	if ctx.Server != nil {
+		server = ctx.Server.Meta.Addr
+	}
+	status := metrics.StatusClass(ctx.ReqCtx.Response.StatusCode())
+	metrics.RequestsTotal.WithLabelValues(route, service, api, server, status).Inc()
+	metrics.RequestDuration.WithLabelValues(route, service, api, server, status).Observe(time.Since(ctx.Start).Seconds())
+}
+
 func doRoute(ctx *core.RequestContext) (err error) {
 	reqc := ctx.ReqCtx
 	if !ctx.Hosts.ValidateHost(ctx) {
-		ctx.WriteError(fasthttp.StatusNotFound)
+		ctx.WriteErrorWith(fasthttp.StatusNotFound, core.ErrHostNotAllow, "")
 		return core.ErrHostNotAllow
 	}
+	if core.IsPreflight(reqc) {
+		if handled, err := doPreflight(ctx); handled {
+			return err
+		}
+	}
 	url := reflectx.BytesToString(reqc.Path())
 	method := reflectx.BytesToString(reqc.Method())
-	rt := ctx.Router
-	result := rt.NewResult()
-	if !rt.Find(method, url, result) {
-		ctx.WriteError(fasthttp.StatusNotFound)
+	result, ok := ctx.Routers.Find(ctx.Host, method, url)
+	if !ok {
+		ctx.WriteErrorWith(fasthttp.StatusNotFound, core.ErrRouteNotFound, "")
 		return core.ErrRouteNotFound
 	}
 	if result.MethodNotAllow {
-		ctx.WriteError(fasthttp.StatusMethodNotAllowed)
+		ctx.WriteErrorWith(fasthttp.StatusMethodNotAllowed, core.ErrMethodNotAllow, "")
 		return core.ErrMethodNotAllow
 	}
 	route := result.Dest.(*core.Route)
+	ctx.Route = route
 	if route.Meta.Status == meta.Status_Close {
 		if cobrax.Flags.Debug {
-			log.Debugf("[handle] %s %s route closed", route.Meta.Method, route.Meta.Path)
+			log.Debugf("[handle] [%s] %s %s route closed", ctx.RequestId, route.Meta.Method, route.Meta.Path)
 		}
-		ctx.WriteError(fasthttp.StatusNotFound)
+		ctx.WriteErrorWith(fasthttp.StatusNotFound, core.ErrRouteNotFound, "")
 		return core.ErrRouteNotFound
 	}
+	if route.Static != nil {
+		if err := checkScopedIPAcl(ctx); err != nil {
+			return err
+		}
+		route.Static.Serve(ctx)
+		return filters.ErrExit
+	}
 	if route.Context != nil {
 		ctx.ValueContexts = append(ctx.ValueContexts, route.Context)
 	}
 	ctx.PathNames, ctx.PathValues = result.PathParams, result.PathValues
 	api := route.Dispatch(ctx)
 	if api == nil {
-		ctx.WriteError(fasthttp.StatusNotFound)
+		ctx.WriteErrorWith(fasthttp.StatusNotFound, core.ErrRouteNotFound, "")
 		return core.ErrRouteNotFound
 	}
 	return
 }
 
+// doPreflight 按照预检请求的目标方法查找api，找到跨域配置时直接响应，否则按普通的OPTIONS请求路由
+func doPreflight(ctx *core.RequestContext) (bool, error) {
+	reqc := ctx.ReqCtx
+	url := reflectx.BytesToString(reqc.Path())
+	method := reflectx.BytesToString(reqc.Request.Header.Peek("Access-Control-Request-Method"))
+	vcs := len(ctx.ValueContexts)
+	if result, ok := ctx.Routers.Find(ctx.Host, method, url); ok && !result.MethodNotAllow {
+		route := result.Dest.(*core.Route)
+		if route.Meta.Status != meta.Status_Close {
+			ctx.Route = route
+			ctx.PathNames, ctx.PathValues = result.PathParams, result.PathValues
+			route.Dispatch(ctx)
+		}
+	}
+	policy := ctx.CorsPolicy()
+	if policy == nil {
+		ctx.Route, ctx.Service, ctx.Api = nil, nil, nil
+		ctx.PathNames, ctx.PathValues = nil, nil
+		ctx.ValueContexts = ctx.ValueContexts[:vcs]
+		return false, nil
+	}
+	if err := ctx.WritePreflight(policy); err != nil {
+		return true, err
+	}
+	if cobrax.Flags.Debug {
+		log.Debugf("[handle] [%s] preflight %s %s ok", ctx.RequestId, method, url)
+	}
+	return true, filters.ErrExit
+}
+
+func checkGlobalIPAcl(ctx *core.RequestContext) error {
+	if !ctx.IPAcls.AllowGlobal(ctx.GetRealClientIP()) {
+		if cobrax.Flags.Debug {
+			log.Debugf("[handle] [%s] client %s denied by global ip acl", ctx.RequestId, ctx.GetRealClientAddr())
+		}
+		ctx.WriteErrorWith(fasthttp.StatusForbidden, core.ErrIPNotAllow, "")
+		return core.ErrIPNotAllow
+	}
+	return nil
+}
+
+func checkScopedIPAcl(ctx *core.RequestContext) error {
+	var route, service, apiId string
+	if ctx.Route != nil {
+		route = ctx.Route.Meta.Id
+	}
+	if ctx.Service != nil {
+		service = ctx.Service.Meta.Id
+	}
+	if ctx.Api != nil {
+		apiId = ctx.Api.Meta.Id
+	}
+	if !ctx.IPAcls.AllowScoped(ctx.GetRealClientIP(), route, service, apiId) {
+		if cobrax.Flags.Debug {
+			log.Debugf("[handle] [%s] client %s denied by ip acl, route=%s, service=%s, api=%s", ctx.RequestId, ctx.GetRealClientAddr(), route, service, apiId)
+		}
+		ctx.WriteErrorWith(fasthttp.StatusForbidden, core.ErrIPNotAllow, "")
+		return core.ErrIPNotAllow
+	}
+	return nil
+}
+
 func doForward(ctx *core.RequestContext) error {
 	a := ctx.Api
 	if a.Context != nil {
@@ -91,7 +212,10 @@ func doForward(ctx *core.RequestContext) error {
 	if a.Validators.Validate(ctx) == false {
 		return core.ErrApiValidateFailed
 	}
+	span := ctx.Span.StartChild("auth", tracing.SpanKindInternal)
 	err := a.DoAuth(ctx)
+	span.SetError(err)
+	span.Finish()
 	if err != nil {
 		return err
 	}
@@ -100,6 +224,15 @@ func doForward(ctx *core.RequestContext) error {
 	freq.Reset()
 	reqc.Request.CopyTo(freq)
 	ctx.ForwardReq = freq
+	core.RemoveHopHeaders(&freq.Header)
+	if core.IsWebSocket(&reqc.Request.Header) {
+		freq.Header.Set("Connection", "Upgrade")
+		freq.Header.Set("Upgrade", "websocket")
+	}
+	if body, ok := ctx.Attrs[bodyStreamKey].(*limitedBody); ok {
+		freq.SetBodyStream(body, reqc.Request.Header.ContentLength())
+	}
+	core.SetProxyHeaders(ctx, ctx.Service.Config.ProxyHeaders)
 	err = a.RewriteURL(ctx)
 	if err != nil {
 		return err
@@ -119,9 +252,9 @@ func doForward(ctx *core.RequestContext) error {
 	}
 	if svr == nil {
 		if cobrax.Flags.Debug {
-			log.Debugf("[handle] no server available for api(%s) service(%s)", a.Meta.Id, ctx.Service.Meta.Name)
+			log.Debugf("[handle] [%s] no server available for api(%s) service(%s)", ctx.RequestId, a.Meta.Id, ctx.Service.Meta.Name)
 		}
-		ctx.WriteError(fasthttp.StatusServiceUnavailable)
+		ctx.WriteErrorWith(fasthttp.StatusServiceUnavailable, core.ErrServiceUnavailable, "")
 		return core.ErrServiceUnavailable
 	}
 	ctx.Server = svr
@@ -129,15 +262,32 @@ func doForward(ctx *core.RequestContext) error {
 }
 
 func doDispatch(ctx *core.RequestContext) error {
+	if ctx.ForwardResp != nil {
+		// response has been provided by hooks, e.g. cache hit
+		return nil
+	}
 	if len(ctx.Api.Meta.Lambda) > 0 {
 		a := ctx.Api
 		return a.EvalLambda(ctx, a.Meta.Lambda)
 	} else {
 		fresp := fasthttp.AcquireResponse()
 		ctx.ForwardResp = fresp
-		err := ctx.Server.Forward(ctx.ForwardReq, fresp)
+		inflight := metrics.RequestsInFlight.WithLabelValues(ctx.Service.Meta.Name, ctx.Server.Meta.Addr)
+		inflight.Inc()
+		start := time.Now()
+		err := ctx.Server.ForwardDeadline(ctx.ForwardReq, fresp, ctx.Deadline)
+		ctx.UpstreamCost = time.Since(start)
+		inflight.Dec()
 		if err != nil {
-			ctx.WriteError(http.StatusInternalServerError)
+			metrics.BackendErrors.WithLabelValues(ctx.Service.Meta.Name, ctx.Server.Meta.Addr).Inc()
+			if core.IsTimeout(err) {
+				ctx.WriteErrorWith(http.StatusGatewayTimeout, core.ErrGatewayTimeout, "")
+				return core.ErrGatewayTimeout
+			}
+			if forwardBodyTooLarge(ctx, err) {
+				return core.ErrBodyTooLarge
+			}
+			ctx.WriteErrorWith(http.StatusBadGateway, core.ErrServiceUnavailable, "")
 			return err
 		}
 	}
@@ -148,8 +298,19 @@ func finishForward(ctx *core.RequestContext) (err error) {
 	a := ctx.Api
 	a.SetResponseHeader(ctx)
 	a.SetResponseCookie(ctx)
+	a.SetDeprecationHeader(ctx)
 	dst := &ctx.ReqCtx.Response
+	core.RemoveHopHeaders(&ctx.ForwardResp.Header)
 	ctx.ForwardResp.Header.CopyTo(&dst.Header)
+	if ctx.ForwardResp.IsBodyStream() {
+		// body will be streamed to client by stream proxy
+		return nil
+	}
+	if hasTrailer(&ctx.ForwardResp.Header) {
+		// trailers are only sent with chunked body
+		dst.SetBodyStream(bytes.NewReader(append([]byte(nil), ctx.ForwardResp.Body()...)), -1)
+		return nil
+	}
 	err = ctx.ForwardResp.BodyWriteTo(dst.BodyWriter())
 	if err != nil {
 		log.Error("[handle] write to response error : ", err)
@@ -159,6 +320,13 @@ func finishForward(ctx *core.RequestContext) (err error) {
 	return nil
 }
 
+func hasTrailer(h *fasthttp.ResponseHeader) (ok bool) {
+	h.VisitAllTrailer(func(value []byte) {
+		ok = true
+	})
+	return ok
+}
+
 func finishDispatch(ctx *core.RequestContext) error {
 	if ctx.Err != nil {
 		ctx.WriteError(fasthttp.StatusInternalServerError)
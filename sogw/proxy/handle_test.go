@@ -0,0 +1,42 @@
+package proxy
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestObserveRequest(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	addr := ln.Addr().String()
+	ln.Close()
+
+	svc := &core.Service{Meta: &meta.Service{Name: "metrics-svc"}}
+	ctx := core.NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.Route = &core.Route{Meta: &meta.Route{Id: "metrics-route"}}
+	ctx.Service = svc
+	ctx.Api = core.NewApi(&meta.Api{Id: "metrics-api"}, svc)
+	ctx.Server = core.NewServer(&meta.Server{Addr: addr})
+	ctx.ForwardReq = &fasthttp.Request{}
+	ctx.ForwardReq.SetRequestURI("/users")
+	assert.NotNil(t, doDispatch(ctx))
+	assert.Equal(t, fasthttp.StatusBadGateway, ctx.ReqCtx.Response.StatusCode())
+	observeRequest(ctx)
+
+	rec := httptest.NewRecorder()
+	promhttp.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	out := rec.Body.String()
+	labels := `api="metrics-api",route="metrics-route",server="` + addr + `",service="metrics-svc",status="5xx"`
+	assert.Contains(t, out, `sogw_proxy_requests_total{`+labels+`} 1`)
+	assert.Contains(t, out, `sogw_proxy_request_duration_seconds_bucket{`+labels+`,le="+Inf"} 1`)
+	assert.Contains(t, out, `sogw_proxy_request_duration_seconds_count{`+labels+`} 1`)
+	assert.Contains(t, out, `sogw_proxy_backend_errors_total{server="`+addr+`",service="metrics-svc"} 1`)
+}
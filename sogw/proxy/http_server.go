@@ -0,0 +1,46 @@
+package proxy
+
+import (
+	"net"
+	"os"
+
+	"github.com/valyala/fasthttp"
+)
+
+// httpServer 监听tcp或者unix socket的http服务，tcp监听可以开启PROXY protocol
+type httpServer struct {
+	svr      *fasthttp.Server
+	ln       net.Listener
+	certFile string
+	keyFile  string
+}
+
+func newHttpServer(svr *fasthttp.Server, network, addr, certFile, keyFile string, proxyProtocol bool) (*httpServer, error) {
+	if network == "unix" {
+		os.Remove(addr)
+	}
+	ln, err := net.Listen(network, addr)
+	if err != nil {
+		return nil, err
+	}
+	if proxyProtocol {
+		ln = &proxyProtoListener{Listener: ln}
+	}
+	return &httpServer{
+		svr:      svr,
+		ln:       ln,
+		certFile: certFile,
+		keyFile:  keyFile,
+	}, nil
+}
+
+func (s *httpServer) Serve() error {
+	if len(s.certFile) > 0 {
+		return s.svr.ServeTLS(s.ln, s.certFile, s.keyFile)
+	}
+	return s.svr.Serve(s.ln)
+}
+
+func (s *httpServer) Close() error {
+	return s.ln.Close()
+}
@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "sogw"
+
+var requestLabels = []string{"route", "service", "api", "server", "status"}
+
+var (
+	RequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "proxy",
+		Name:      "requests_total",
+		Help:      "Total number of proxied requests.",
+	}, requestLabels)
+
+	RequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "proxy",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of proxied requests in seconds.",
+		Buckets:   prometheus.DefBuckets,
+	}, requestLabels)
+
+	RequestsInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "proxy",
+		Name:      "requests_in_flight",
+		Help:      "Number of requests being forwarded to backends.",
+	}, []string{"service", "server"})
+
+	BackendErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "proxy",
+		Name:      "backend_errors_total",
+		Help:      "Total number of backend connection errors.",
+	}, []string{"service", "server"})
+
+	StoreEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "store",
+		Name:      "watch_events_total",
+		Help:      "Total number of events received from store watch.",
+	}, []string{"type", "op"})
+
+	WebSocketConnections = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "proxy",
+		Name:      "websocket_connections",
+		Help:      "Number of proxied websocket connections.",
+	}, []string{"service", "server"})
+
+	CacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "lookups_total",
+		Help:      "Total number of response cache lookups by result.",
+	}, []string{"result"})
+
+	MirrorRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "proxy",
+		Name:      "mirror_requests_total",
+		Help:      "Total number of mirrored requests by result.",
+	}, []string{"service", "result"})
+)
+
+func init() {
+	prometheus.MustRegister(RequestsTotal, RequestDuration, RequestsInFlight, BackendErrors, StoreEvents, WebSocketConnections, CacheLookups, MirrorRequests)
+}
+
+// StatusClass 将状态码归类为 1xx、2xx、3xx、4xx、5xx
+func StatusClass(code int) string {
+	if code < 100 || code >= 600 {
+		return strconv.Itoa(code)
+	}
+	return string([]byte{byte('0' + code/100), 'x', 'x'})
+}
+
+// Server 暴露 /metrics 的HTTP服务
+type Server struct {
+	svr *http.Server
+	ln  net.Listener
+}
+
+func NewServer(addr string) (*Server, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	return &Server{
+		svr: &http.Server{Handler: mux},
+		ln:  ln,
+	}, nil
+}
+
+func (s *Server) Serve() error {
+	err := s.svr.Serve(s.ln)
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+func (s *Server) Close() error {
+	return s.svr.Close()
+}
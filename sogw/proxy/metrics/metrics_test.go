@@ -0,0 +1,15 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusClass(t *testing.T) {
+	assert.Equal(t, "2xx", StatusClass(200))
+	assert.Equal(t, "3xx", StatusClass(304))
+	assert.Equal(t, "4xx", StatusClass(404))
+	assert.Equal(t, "5xx", StatusClass(502))
+	assert.Equal(t, "0", StatusClass(0))
+}
@@ -0,0 +1,128 @@
+package proxy
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/recallsong/cliframe/cobrax"
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/sogw/proxy/metrics"
+	"github.com/recallsong/sogw/store/meta"
+	log "github.com/sirupsen/logrus"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	DefaultMirrorMaxConcurrency = 100
+	DefaultMirrorTimeout        = 5 * time.Second
+)
+
+type MirrorConfig struct {
+	// max number of mirrored requests in flight, more requests are not mirrored
+	MaxConcurrency int `mapstructure:"max_concurrency"`
+	// timeout of mirrored requests, can be overridden by the mirror of route or api
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// requestMirror 将请求异步复制到另一个服务，丢弃其响应，不影响原请求的处理
+type requestMirror struct {
+	sem     chan struct{}
+	timeout time.Duration
+}
+
+func newRequestMirror(cfg *MirrorConfig) *requestMirror {
+	n := cfg.MaxConcurrency
+	if n <= 0 {
+		n = DefaultMirrorMaxConcurrency
+	}
+	m := &requestMirror{
+		sem:     make(chan struct{}, n),
+		timeout: cfg.Timeout,
+	}
+	if m.timeout <= 0 {
+		m.timeout = DefaultMirrorTimeout
+	}
+	return m
+}
+
+// mirrorOf api的配置优先于路由的配置
+func mirrorOf(ctx *core.RequestContext) *meta.Mirror {
+	if ctx.Api != nil && ctx.Api.Meta.Mirror != nil {
+		return ctx.Api.Meta.Mirror
+	}
+	if ctx.Route != nil {
+		return ctx.Route.Meta.Mirror
+	}
+	return nil
+}
+
+// send 按照比例复制转发的请求，以流的方式转发body的请求和WebSocket请求不复制，总是返回nil
+func (m *requestMirror) send(ctx *core.RequestContext) error {
+	mc := mirrorOf(ctx)
+	freq := ctx.ForwardReq
+	if mc == nil || mc.Percent <= 0 || freq == nil || freq.IsBodyStream() || core.IsWebSocket(&freq.Header) {
+		return nil
+	}
+	if mc.Percent < 100 && rand.Int31n(100) >= mc.Percent {
+		return nil
+	}
+	svr := m.selectServer(ctx, mc)
+	if svr == nil {
+		return nil
+	}
+	select {
+	case m.sem <- struct{}{}:
+	default:
+		metrics.MirrorRequests.WithLabelValues(mc.Service, "dropped").Inc()
+		if cobrax.Flags.Debug {
+			log.Debugf("[mirror] [%s] too many mirrored requests, skip", ctx.RequestId)
+		}
+		return nil
+	}
+	timeout := m.timeout
+	if mc.Timeout > 0 {
+		timeout = time.Duration(mc.Timeout) * time.Millisecond
+	}
+	req := fasthttp.AcquireRequest()
+	freq.CopyTo(req)
+	go m.forward(ctx.RequestId, mc.Service, svr, req, timeout)
+	return nil
+}
+
+func (m *requestMirror) selectServer(ctx *core.RequestContext, mc *meta.Mirror) *core.Server {
+	ser, ok := ctx.Services[mc.Service]
+	if !ok {
+		if cobrax.Flags.Debug {
+			log.Debugf("[mirror] [%s] service (id=%s) not found", ctx.RequestId, mc.Service)
+		}
+		return nil
+	}
+	var svr *core.Server
+	if len(mc.ServerId) > 0 {
+		svr = ser.Servers[mc.ServerId]
+	} else {
+		svr = ser.LB.Select(ctx, ser.ServerList)
+	}
+	if svr == nil && cobrax.Flags.Debug {
+		log.Debugf("[mirror] [%s] no server available for service(%s)", ctx.RequestId, mc.Service)
+	}
+	return svr
+}
+
+func (m *requestMirror) forward(reqId, service string, svr *core.Server, req *fasthttp.Request, timeout time.Duration) {
+	resp := fasthttp.AcquireResponse()
+	defer func() {
+		fasthttp.ReleaseRequest(req)
+		fasthttp.ReleaseResponse(resp)
+		<-m.sem
+	}()
+	err := svr.ForwardDeadline(req, resp, time.Now().Add(timeout))
+	if err != nil {
+		metrics.MirrorRequests.WithLabelValues(service, "error").Inc()
+		if cobrax.Flags.Debug {
+			log.Debugf("[mirror] [%s] mirror to %s error : %v", reqId, svr.Meta.Addr, err)
+		}
+		return
+	}
+	metrics.MirrorRequests.WithLabelValues(service, "ok").Inc()
+}
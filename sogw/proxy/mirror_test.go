@@ -0,0 +1,55 @@
+package proxy
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestRequestMirror(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer ln.Close()
+	received := make(chan string, 1)
+	release := make(chan struct{})
+	go fasthttp.Serve(ln, func(c *fasthttp.RequestCtx) {
+		received <- string(c.Request.RequestURI()) + " " + string(c.Request.Body())
+		<-release
+	})
+
+	shadow := core.NewService(&meta.Service{Id: "shadow", Name: "shadow"})
+	shadow.Init(nil)
+	shadow.Servers["s1"] = shadow.NewServer(&meta.Server{Id: "s1", Addr: ln.Addr().String()})
+	newCtx := func(mirror *meta.Mirror) *core.RequestContext {
+		ctx := core.NewRequestContext(&fasthttp.RequestCtx{})
+		ctx.Services = map[string]*core.Service{"shadow": shadow}
+		ctx.Route = core.NewRoute(&meta.Route{Id: "r1", Mirror: &meta.Mirror{Service: "none", Percent: 100}})
+		ctx.Api = core.NewApi(&meta.Api{Id: "api1", Mirror: mirror}, shadow)
+		ctx.ForwardReq = &fasthttp.Request{}
+		ctx.ForwardReq.Header.SetMethod("POST")
+		ctx.ForwardReq.SetRequestURI("http://example.com/users?id=1")
+		ctx.ForwardReq.SetBodyString("hello")
+		return ctx
+	}
+	m := newRequestMirror(&MirrorConfig{MaxConcurrency: 1})
+	assert.Nil(t, m.send(newCtx(&meta.Mirror{Service: "shadow", ServerId: "s1", Percent: 100})))
+	select {
+	case r := <-received:
+		assert.Equal(t, "/users?id=1 hello", r)
+	case <-time.After(time.Second):
+		t.Fatal("request is not mirrored")
+	}
+	// the only slot is in use, the request is dropped
+	assert.Nil(t, m.send(newCtx(&meta.Mirror{Service: "shadow", ServerId: "s1", Percent: 100})))
+	assert.Equal(t, 1, len(m.sem))
+	close(release)
+
+	// route mirror targets an unknown service
+	assert.Nil(t, m.send(newCtx(nil)))
+	assert.Nil(t, m.send(newCtx(&meta.Mirror{Service: "shadow", ServerId: "s1"})))
+}
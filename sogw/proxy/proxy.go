@@ -7,13 +7,18 @@ import (
 
 	"github.com/recallsong/go-utils/ioutil"
 	"github.com/recallsong/go-utils/lang"
-	"github.com/recallsong/go-utils/net/fasthttpx"
 	"github.com/recallsong/go-utils/net/servegrp"
+	"github.com/recallsong/sogw/sogw/proxy/accesslog"
+	"github.com/recallsong/sogw/sogw/proxy/cache"
+	"github.com/recallsong/sogw/sogw/proxy/compress"
 	"github.com/recallsong/sogw/sogw/proxy/core"
 	"github.com/recallsong/sogw/sogw/proxy/filters"
 	"github.com/recallsong/sogw/sogw/proxy/jobs"
 	"github.com/recallsong/sogw/sogw/proxy/jobs/healthchecker"
+	"github.com/recallsong/sogw/sogw/proxy/metrics"
+	"github.com/recallsong/sogw/sogw/proxy/tracing"
 	log "github.com/sirupsen/logrus"
+	"github.com/valyala/fasthttp"
 )
 
 type HttpProxy struct {
@@ -24,6 +29,14 @@ type HttpProxy struct {
 	filters   *filters.FilterManager
 	jobs      *jobs.JobManager
 	rtCtx     *core.RuntimeContext
+	trusted   core.TrustedProxies
+	tracer    *tracing.Tracer
+	accessLog *accesslog.Logger
+	cache     *cache.Cache
+	compress  *compress.Compressor
+	body      *requestBody
+	timeout   *requestTimeout
+	mirror    *requestMirror
 }
 
 func New() *HttpProxy {
@@ -35,6 +48,39 @@ func New() *HttpProxy {
 
 func (p *HttpProxy) Init(c *Config) error {
 	p.cfg = c
+	if len(c.RequestIdHeader) <= 0 {
+		c.RequestIdHeader = core.DefaultRequestIdHeader
+	}
+	trusted, err := core.NewTrustedProxies(c.TrustedProxies)
+	if err != nil {
+		log.Errorf("[proxy] invalid trusted proxies : %v", err)
+		return err
+	}
+	p.trusted = trusted
+	if c.AccessLog.Enable {
+		p.accessLog, err = accesslog.New(&c.AccessLog)
+		if err != nil {
+			log.Errorf("[proxy] init access log error : %v", err)
+			return err
+		}
+	}
+	if len(c.Tracing.Endpoint) > 0 {
+		p.tracer = tracing.NewTracer(&c.Tracing, tracing.NewOTLPExporter(c.Tracing.Endpoint, c.Tracing.ServiceName))
+		log.Infof("[proxy] export traces to %s", c.Tracing.Endpoint)
+	}
+	if c.Compression.Enable || c.Compression.DecompressRequest {
+		p.compress, err = compress.New(&c.Compression)
+		if err != nil {
+			log.Errorf("[proxy] init compression error : %v", err)
+			return err
+		}
+	}
+	if c.Cache.Enable {
+		p.cache = cache.New(&c.Cache)
+	}
+	p.body = newRequestBody(&c.RequestBody)
+	p.timeout = newRequestTimeout(&c.Timeout)
+	p.mirror = newRequestMirror(&c.Mirror)
 	if err := p.initStore(&c.Store); err != nil {
 		return err
 	}
@@ -65,8 +111,7 @@ func (p *HttpProxy) initJobs(cfg map[string]interface{}) error {
 
 func (p *HttpProxy) initServers(c *Config) error {
 	if c.Addr != "" {
-		svr := fasthttpx.NewTcpServer(p.Handler)
-		err := svr.Listen(c.Addr)
+		svr, err := p.newServer("tcp", c.Addr, "", "", c.ProxyProtocol)
 		if err != nil {
 			log.Errorf("[proxy] %v", err)
 			return err
@@ -76,7 +121,7 @@ func (p *HttpProxy) initServers(c *Config) error {
 			log.Errorf("[proxy] %v", err)
 			return err
 		}
-		log.Infof("[proxy] listen tcp [ %s ] ok", c.Addr)
+		log.Infof("[proxy] listen tcp [ %s ] ok, proxy protocol : %v", c.Addr, c.ProxyProtocol)
 	}
 	if c.TLSAddr != "" {
 		parts := strings.Split(c.TLSAddr, ",")
@@ -85,8 +130,7 @@ func (p *HttpProxy) initServers(c *Config) error {
 			log.Error("[proxy] ", err)
 			return err
 		}
-		svr := fasthttpx.NewTLSTcpServer(p.Handler, parts[1], parts[2])
-		err := svr.Listen(parts[0])
+		svr, err := p.newServer("tcp", parts[0], parts[1], parts[2], c.ProxyProtocol)
 		if err != nil {
 			log.Errorf("[proxy] %v", err)
 			return err
@@ -96,11 +140,10 @@ func (p *HttpProxy) initServers(c *Config) error {
 			log.Errorf("[proxy] %v", err)
 			return err
 		}
-		log.Infof("[proxy] listen tcp (tls) [ %s ] ok", parts[0])
+		log.Infof("[proxy] listen tcp (tls) [ %s ] ok, proxy protocol : %v", parts[0], c.ProxyProtocol)
 	}
 	if c.UnixAddr != "" {
-		svr := fasthttpx.NewUnixServer(p.Handler)
-		err := svr.Listen(c.UnixAddr)
+		svr, err := p.newServer("unix", c.UnixAddr, "", "", false)
 		if err != nil {
 			log.Errorf("[proxy] %v", err)
 			return err
@@ -112,19 +155,72 @@ func (p *HttpProxy) initServers(c *Config) error {
 		}
 		log.Infof("[proxy] listen unix socket [ %s ] ok", c.UnixAddr)
 	}
+	if err := p.initGrpcServers(&c.Grpc); err != nil {
+		return err
+	}
 	if p.svrGrp.Num() <= 0 {
 		err := fmt.Errorf("no address to listen")
 		log.Errorf("[proxy] %v", err)
 		return err
 	}
+	if c.MetricsAddr != "" {
+		svr, err := metrics.NewServer(c.MetricsAddr)
+		if err != nil {
+			log.Errorf("[proxy] %v", err)
+			return err
+		}
+		err = p.svrGrp.Put(c.MetricsAddr, svr)
+		if err != nil {
+			log.Errorf("[proxy] %v", err)
+			return err
+		}
+		log.Infof("[proxy] listen metrics [ %s ] ok", c.MetricsAddr)
+	}
 	return nil
 }
 
+func (p *HttpProxy) newServer(network, addr, certFile, keyFile string, proxyProtocol bool) (servegrp.ServeItem, error) {
+	svr := &fasthttp.Server{
+		Handler: p.Handler,
+		// bodies larger than buffer size are handled by requestBody
+		MaxRequestBodySize:           p.body.bufferSize,
+		StreamRequestBody:            true,
+		DisablePreParseMultipartForm: true,
+	}
+	return newHttpServer(svr, network, addr, certFile, keyFile, proxyProtocol)
+}
+
 func (p *HttpProxy) initFilters(cfg map[string]interface{}) error {
 	p.filters = filters.NewFilterManager()
-	p.filters.PushStepPair(filters.BeforeAll, doRoute, filters.AfterAll, nil)
+	p.filters.PushStepPair(filters.BeforeAll, traceStep("route", doRoute), filters.AfterAll, nil)
 	p.filters.PushStepPair(filters.BeforeForward, doForward, filters.AfterForward, finishForward)
 	p.filters.PushStepPair(filters.BeforeDispatch, doDispatch, filters.AfterDispatch, finishDispatch)
+	p.filters.AddHook(filters.BeforeAll, checkGlobalIPAcl)
+	p.filters.AddHook(filters.BeforeAll, p.body.limit)
+	p.filters.AddHook(filters.BeforeForward, checkScopedIPAcl)
+	p.filters.AddHook(filters.BeforeForward, p.body.prepare)
+	if p.compress != nil && p.cfg.Compression.DecompressRequest {
+		p.filters.AddHook(filters.BeforeForward, p.compress.DecompressRequest)
+	}
+	if p.compress != nil && p.cfg.Compression.Enable {
+		p.filters.AddHook(filters.AfterForward, p.compress.CompressResponse)
+	}
+	p.filters.AddHook(filters.BeforeDispatch, p.timeout.prepare)
+	p.filters.AddHook(filters.BeforeDispatch, p.mirror.send)
+	if p.cache != nil {
+		p.filters.AddPair(filters.BeforeDispatch, p.cache)
+	}
+	// trace context must be injected before websocket, gRPC and stream proxies send the request
+	if p.tracer != nil {
+		p.filters.AddPair(filters.BeforeDispatch, dispatchTracer{})
+	}
+	ws := newWebSocketProxy(&p.cfg.WebSocket)
+	p.filters.AddPair(filters.BeforeDispatch, ws)
+	p.filters.AddHook(filters.AfterForward, ws.finishUpgrade)
+	p.filters.AddPair(filters.BeforeDispatch, newGrpcTranscoder(&p.cfg.Grpc))
+	stream := newStreamProxy(&p.cfg.Stream)
+	p.filters.AddPair(filters.BeforeDispatch, stream)
+	p.filters.AddHook(filters.AfterForward, stream.finishStream)
 	return p.filters.Init(cfg)
 }
 
@@ -142,5 +238,5 @@ func (p *HttpProxy) Start(closeCh <-chan os.Signal) error {
 
 func (p *HttpProxy) Close() error {
 	log.Info("[proxy] stop servers")
-	return ioutil.CloseMulti(p.svrGrp, p.jobs, p.waitClose)
+	return ioutil.CloseMulti(p.svrGrp, p.jobs, p.waitClose, p.tracer, p.accessLog)
 }
@@ -0,0 +1,161 @@
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	proxyProtoV1Prefix  = []byte("PROXY ")
+	proxyProtoV2Sig     = []byte("\r\n\r\n\x00\r\nQUIT\n")
+	errProxyProtoHeader = errors.New("invalid proxy protocol header")
+)
+
+const proxyProtoHeaderTimeout = 5 * time.Second
+
+type proxyProtoListener struct {
+	net.Listener
+}
+
+func (l *proxyProtoListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &proxyProtoConn{Conn: conn, r: bufio.NewReader(conn)}, nil
+}
+
+// proxyProtoConn 在第一次读取或获取地址时解析PROXY protocol头
+type proxyProtoConn struct {
+	net.Conn
+	r       *bufio.Reader
+	once    sync.Once
+	err     error
+	srcAddr net.Addr
+	dstAddr net.Addr
+}
+
+func (c *proxyProtoConn) Read(b []byte) (int, error) {
+	c.once.Do(c.readHeader)
+	if c.err != nil {
+		return 0, c.err
+	}
+	return c.r.Read(b)
+}
+
+func (c *proxyProtoConn) RemoteAddr() net.Addr {
+	c.once.Do(c.readHeader)
+	if c.srcAddr != nil {
+		return c.srcAddr
+	}
+	return c.Conn.RemoteAddr()
+}
+
+func (c *proxyProtoConn) LocalAddr() net.Addr {
+	c.once.Do(c.readHeader)
+	if c.dstAddr != nil {
+		return c.dstAddr
+	}
+	return c.Conn.LocalAddr()
+}
+
+func (c *proxyProtoConn) readHeader() {
+	c.Conn.SetReadDeadline(time.Now().Add(proxyProtoHeaderTimeout))
+	c.srcAddr, c.dstAddr, c.err = readProxyProtoHeader(c.r)
+	c.Conn.SetReadDeadline(time.Time{})
+	if c.err != nil {
+		c.Conn.Close()
+	}
+}
+
+func readProxyProtoHeader(r *bufio.Reader) (src, dst net.Addr, err error) {
+	sig, err := r.Peek(len(proxyProtoV2Sig))
+	if err != nil {
+		return nil, nil, err
+	}
+	if bytes.Equal(sig, proxyProtoV2Sig) {
+		return readProxyProtoV2(r)
+	}
+	if bytes.HasPrefix(sig, proxyProtoV1Prefix) {
+		return readProxyProtoV1(r)
+	}
+	return nil, nil, errProxyProtoHeader
+}
+
+// readProxyProtoV1 例如：PROXY TCP4 192.168.0.1 192.168.0.11 56324 443\r\n
+func readProxyProtoV1(r *bufio.Reader) (src, dst net.Addr, err error) {
+	var line []byte
+	for len(line) < 107 {
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, nil, err
+		}
+		line = append(line, b)
+		if b == '\n' {
+			break
+		}
+	}
+	if !bytes.HasSuffix(line, []byte("\r\n")) {
+		return nil, nil, errProxyProtoHeader
+	}
+	fields := strings.Fields(string(line[:len(line)-2]))
+	if len(fields) >= 2 && fields[1] == "UNKNOWN" {
+		return nil, nil, nil
+	}
+	if len(fields) != 6 || (fields[1] != "TCP4" && fields[1] != "TCP6") {
+		return nil, nil, errProxyProtoHeader
+	}
+	srcIP, dstIP := net.ParseIP(fields[2]), net.ParseIP(fields[3])
+	srcPort, err1 := strconv.ParseUint(fields[4], 10, 16)
+	dstPort, err2 := strconv.ParseUint(fields[5], 10, 16)
+	if srcIP == nil || dstIP == nil || err1 != nil || err2 != nil {
+		return nil, nil, errProxyProtoHeader
+	}
+	return &net.TCPAddr{IP: srcIP, Port: int(srcPort)}, &net.TCPAddr{IP: dstIP, Port: int(dstPort)}, nil
+}
+
+func readProxyProtoV2(r *bufio.Reader) (src, dst net.Addr, err error) {
+	var hdr [16]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, nil, err
+	}
+	if hdr[12]>>4 != 2 {
+		return nil, nil, errProxyProtoHeader
+	}
+	cmd, fam := hdr[12]&0x0F, hdr[13]
+	data := make([]byte, binary.BigEndian.Uint16(hdr[14:16]))
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, nil, err
+	}
+	// LOCAL命令表示代理自身的连接，例如健康检查
+	if cmd == 0 {
+		return nil, nil, nil
+	}
+	if cmd != 1 {
+		return nil, nil, errProxyProtoHeader
+	}
+	var ipLen int
+	switch fam >> 4 {
+	case 1:
+		ipLen = net.IPv4len
+	case 2:
+		ipLen = net.IPv6len
+	default:
+		return nil, nil, nil
+	}
+	if len(data) < ipLen*2+4 {
+		return nil, nil, errProxyProtoHeader
+	}
+	srcIP, dstIP := net.IP(data[:ipLen]), net.IP(data[ipLen:ipLen*2])
+	srcPort := int(binary.BigEndian.Uint16(data[ipLen*2:]))
+	dstPort := int(binary.BigEndian.Uint16(data[ipLen*2+2:]))
+	return &net.TCPAddr{IP: srcIP, Port: srcPort}, &net.TCPAddr{IP: dstIP, Port: dstPort}, nil
+}
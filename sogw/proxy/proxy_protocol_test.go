@@ -0,0 +1,51 @@
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadProxyProtoV1(t *testing.T) {
+	r := bufio.NewReader(bytes.NewBufferString("PROXY TCP4 192.168.0.1 192.168.0.11 56324 443\r\nGET / HTTP/1.1\r\n"))
+	src, dst, err := readProxyProtoHeader(r)
+	assert.Nil(t, err)
+	assert.Equal(t, "192.168.0.1:56324", src.String())
+	assert.Equal(t, "192.168.0.11:443", dst.String())
+	rest, _ := ioutil.ReadAll(r)
+	assert.Equal(t, "GET / HTTP/1.1\r\n", string(rest))
+
+	r = bufio.NewReader(bytes.NewBufferString("PROXY UNKNOWN\r\nGET / HTTP/1.1\r\n"))
+	src, _, err = readProxyProtoHeader(r)
+	assert.Nil(t, err)
+	assert.Nil(t, src)
+
+	r = bufio.NewReader(bytes.NewBufferString("GET / HTTP/1.1\r\n\r\n"))
+	_, _, err = readProxyProtoHeader(r)
+	assert.Equal(t, errProxyProtoHeader, err)
+}
+
+func TestReadProxyProtoV2(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	buf.Write(proxyProtoV2Sig)
+	buf.Write([]byte{0x21, 0x11, 0x00, 0x0C})
+	buf.Write([]byte{10, 0, 0, 1, 10, 0, 0, 2, 0x1F, 0x90, 0x01, 0xBB})
+	buf.WriteString("GET")
+	r := bufio.NewReader(buf)
+	src, dst, err := readProxyProtoHeader(r)
+	assert.Nil(t, err)
+	assert.Equal(t, "10.0.0.1:8080", src.String())
+	assert.Equal(t, "10.0.0.2:443", dst.String())
+	rest, _ := ioutil.ReadAll(r)
+	assert.Equal(t, "GET", string(rest))
+
+	buf.Reset()
+	buf.Write(proxyProtoV2Sig)
+	buf.Write([]byte{0x20, 0x00, 0x00, 0x00})
+	src, _, err = readProxyProtoHeader(bufio.NewReader(buf))
+	assert.Nil(t, err)
+	assert.Nil(t, src)
+}
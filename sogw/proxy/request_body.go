@@ -0,0 +1,141 @@
+package proxy
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	DefaultMaxRequestBodySize    = fasthttp.DefaultMaxRequestBodySize
+	DefaultRequestBodyBufferSize = 1 << 20
+
+	bodyStreamKey = "_request.body_stream"
+)
+
+type RequestBodyConfig struct {
+	// max request body size in bytes, can be overridden by api, bodies read while routing are limited by it
+	MaxSize int `mapstructure:"max_size"`
+	// bodies larger than this are not read into memory before routing
+	BufferSize int `mapstructure:"buffer_size"`
+	// pipe large bodies to servers without buffering if the api does not read the body
+	Stream bool `mapstructure:"stream"`
+}
+
+// requestBody 限制请求body的大小，对不需要读取body的api以流的方式转发较大的body
+type requestBody struct {
+	maxSize    int
+	bufferSize int
+	stream     bool
+}
+
+func newRequestBody(cfg *RequestBodyConfig) *requestBody {
+	b := &requestBody{
+		maxSize:    cfg.MaxSize,
+		bufferSize: cfg.BufferSize,
+		stream:     cfg.Stream,
+	}
+	if b.maxSize <= 0 {
+		b.maxSize = DefaultMaxRequestBodySize
+	}
+	if b.bufferSize <= 0 {
+		b.bufferSize = DefaultRequestBodyBufferSize
+	}
+	if b.bufferSize > b.maxSize {
+		b.bufferSize = b.maxSize
+	}
+	return b
+}
+
+// limit 在路由之前按照全局的大小限制路由时读取的body
+func (b *requestBody) limit(ctx *core.RequestContext) error {
+	ctx.BodyLimit = b.maxSize
+	return nil
+}
+
+// prepare 检查body大小，未读取的body在需要时读入内存，否则留给doForward以流的方式转发
+func (b *requestBody) prepare(ctx *core.RequestContext) error {
+	if ctx.BodyErr != nil {
+		// body has been read while routing
+		return bodyError(ctx, ctx.BodyLimit)
+	}
+	req := &ctx.ReqCtx.Request
+	limit := b.maxSize
+	if ctx.Api.Meta.MaxBodySize > 0 {
+		limit = int(ctx.Api.Meta.MaxBodySize)
+	}
+	if req.Header.ContentLength() > limit {
+		return bodyTooLarge(ctx, limit)
+	}
+	ctx.BodyLimit = limit
+	stream := ctx.ReqCtx.RequestBodyStream()
+	if stream != nil && b.stream && len(req.Header.Peek("Content-Encoding")) <= 0 && !readsBody(ctx) {
+		ctx.SetAttr(bodyStreamKey, &limitedBody{r: stream, n: limit, limit: limit})
+		return nil
+	}
+	body := ctx.ReadBody()
+	if ctx.BodyErr != nil {
+		return bodyError(ctx, limit)
+	}
+	if len(body) > limit {
+		return bodyTooLarge(ctx, limit)
+	}
+	return nil
+}
+
+func bodyError(ctx *core.RequestContext, limit int) error {
+	if ctx.BodyErr == core.ErrBodyTooLarge {
+		return bodyTooLarge(ctx, limit)
+	}
+	ctx.WriteErrorWith(fasthttp.StatusBadRequest, core.ErrInvalidBody, "fail to read body")
+	return core.ErrInvalidBody
+}
+
+func bodyTooLarge(ctx *core.RequestContext, limit int) error {
+	ctx.ReqCtx.SetConnectionClose()
+	ctx.WriteErrorWith(fasthttp.StatusRequestEntityTooLarge, core.ErrBodyTooLarge, "max body size is "+strconv.Itoa(limit))
+	return core.ErrBodyTooLarge
+}
+
+// forwardBodyTooLarge 转发时流式body超过限制，返回413
+func forwardBodyTooLarge(ctx *core.RequestContext, err error) bool {
+	body, ok := ctx.Attrs[bodyStreamKey].(*limitedBody)
+	if !ok || !errors.Is(err, core.ErrBodyTooLarge) {
+		return false
+	}
+	bodyTooLarge(ctx, body.limit)
+	return true
+}
+
+// readsBody api的校验、取值、lambda或者gRPC转换是否需要读取请求body
+func readsBody(ctx *core.RequestContext) bool {
+	a := ctx.Api
+	if len(a.Meta.Lambda) > 0 || a.Meta.Grpc != nil || len(a.Validators) > 0 || a.Context.ReadsBody() {
+		return true
+	}
+	for _, vc := range ctx.ValueContexts {
+		if vc.ReadsBody() {
+			return true
+		}
+	}
+	return false
+}
+
+// limitedBody 转发时限制流式body的大小，用于没有Content-Length的请求
+type limitedBody struct {
+	r     io.Reader
+	n     int
+	limit int
+}
+
+func (l *limitedBody) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.n -= n
+	if l.n < 0 {
+		return n, core.ErrBodyTooLarge
+	}
+	return n, err
+}
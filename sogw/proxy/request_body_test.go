@@ -0,0 +1,137 @@
+package proxy
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func newBodyContext(body string, api *meta.Api) *core.RequestContext {
+	ctx := core.NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.ReqCtx.Request.Header.SetMethod("POST")
+	ctx.ReqCtx.Request.SetBodyString(body)
+	ctx.Api = core.NewApi(api, &core.Service{})
+	return ctx
+}
+
+func TestRequestBodyLimit(t *testing.T) {
+	b := newRequestBody(&RequestBodyConfig{MaxSize: 10})
+	ctx := newBodyContext("0123456789", &meta.Api{Id: "api1"})
+	assert.Nil(t, b.prepare(ctx))
+
+	ctx = newBodyContext("0123456789a", &meta.Api{Id: "api1"})
+	assert.Equal(t, core.ErrBodyTooLarge, b.prepare(ctx))
+	assert.Equal(t, fasthttp.StatusRequestEntityTooLarge, ctx.ReqCtx.Response.StatusCode())
+
+	ctx = newBodyContext("0123456789a", &meta.Api{Id: "api1", MaxBodySize: 20})
+	assert.Nil(t, b.prepare(ctx))
+}
+
+func TestReadsBody(t *testing.T) {
+	ctx := newBodyContext("", &meta.Api{Id: "api1"})
+	assert.False(t, readsBody(ctx))
+	ctx.ValueContexts = append(ctx.ValueContexts, core.ValueContext{
+		"data": &meta.ValueItem{Source: meta.ValueSource_Request, Name: "body"},
+	})
+	assert.True(t, readsBody(ctx))
+	ctx = newBodyContext("", &meta.Api{Id: "api1", Context: map[string]*meta.ValueItem{
+		"name": {Source: meta.ValueSource_ReqJSONBody, Name: "name"},
+	}})
+	assert.True(t, readsBody(ctx))
+}
+
+func TestLimitedBody(t *testing.T) {
+	data, err := ioutil.ReadAll(&limitedBody{r: strings.NewReader("hello"), n: 5})
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(data))
+	_, err = ioutil.ReadAll(&limitedBody{r: bytes.NewReader([]byte("hello world")), n: 5})
+	assert.Equal(t, core.ErrBodyTooLarge, err)
+}
+
+// serveBody 启动一个以流的方式接收body的代理，经过requestBody、doForward和doDispatch转发到后端
+func serveBody(t *testing.T, b *requestBody, api *meta.Api, route func(ctx *core.RequestContext)) (string, func()) {
+	backend, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	go fasthttp.Serve(backend, func(c *fasthttp.RequestCtx) {
+		c.WriteString(strconv.Itoa(len(c.Request.Body())))
+	})
+	svc := &core.Service{
+		Meta:    &meta.Service{Name: "svc"},
+		Config:  &meta.ServiceConfig{},
+		Servers: map[string]*core.Server{"s1": core.NewServer(&meta.Server{Id: "s1", Addr: backend.Addr().String()})},
+	}
+	api.ServerId = "s1"
+	a := core.NewApi(api, svc)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	svr := &fasthttp.Server{
+		MaxRequestBodySize: b.bufferSize,
+		StreamRequestBody:  true,
+		Handler: func(reqc *fasthttp.RequestCtx) {
+			ctx := core.NewRequestContext(reqc)
+			defer core.ReleaseRequestContext(ctx)
+			ctx.Service, ctx.Api = svc, a
+			b.limit(ctx)
+			if route != nil {
+				route(ctx)
+			}
+			if b.prepare(ctx) != nil || doForward(ctx) != nil || doDispatch(ctx) != nil {
+				return
+			}
+			finishForward(ctx)
+		},
+	}
+	go svr.Serve(ln)
+	return "http://" + ln.Addr().String() + "/", func() {
+		ln.Close()
+		backend.Close()
+	}
+}
+
+func postChunked(t *testing.T, url string, size int) (int, string) {
+	req, resp := fasthttp.AcquireRequest(), fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+	req.SetRequestURI(url)
+	req.Header.SetMethod("POST")
+	req.SetBodyStream(bytes.NewReader(bytes.Repeat([]byte("a"), size)), -1)
+	assert.Nil(t, fasthttp.Do(req, resp))
+	return resp.StatusCode(), string(resp.Body())
+}
+
+func TestRequestBodyStream(t *testing.T) {
+	b := newRequestBody(&RequestBodyConfig{MaxSize: 1 << 16, BufferSize: 16, Stream: true})
+	url, stop := serveBody(t, b, &meta.Api{Id: "api1"}, nil)
+	defer stop()
+	status, body := postChunked(t, url, 1<<15)
+	assert.Equal(t, fasthttp.StatusOK, status)
+	assert.Equal(t, strconv.Itoa(1<<15), body)
+
+	status, _ = postChunked(t, url, 1<<17)
+	assert.Equal(t, fasthttp.StatusRequestEntityTooLarge, status)
+}
+
+func TestRequestBodyReadWhileRouting(t *testing.T) {
+	b := newRequestBody(&RequestBodyConfig{MaxSize: 1 << 16, BufferSize: 16, Stream: true})
+	url, stop := serveBody(t, b, &meta.Api{Id: "api1", MaxBodySize: 1 << 17}, func(ctx *core.RequestContext) {
+		ctx.ValueContexts = append(ctx.ValueContexts, core.ValueContext{
+			"data": &meta.ValueItem{Source: meta.ValueSource_Request, Name: "body"},
+		})
+		ctx.GetAttr("data")
+	})
+	defer stop()
+	status, body := postChunked(t, url, 1<<15)
+	assert.Equal(t, fasthttp.StatusOK, status)
+	assert.Equal(t, strconv.Itoa(1<<15), body)
+
+	status, _ = postChunked(t, url, 1<<16+1)
+	assert.Equal(t, fasthttp.StatusRequestEntityTooLarge, status)
+}
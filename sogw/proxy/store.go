@@ -7,6 +7,7 @@ import (
 
 	"github.com/recallsong/cliframe/cobrax"
 	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/sogw/proxy/metrics"
 	"github.com/recallsong/sogw/sogw/proxy/router"
 	"github.com/recallsong/sogw/store"
 	"github.com/recallsong/sogw/store/meta"
@@ -49,6 +50,16 @@ type routeEvent struct {
 	op   meta.Operation
 }
 
+type ipaclEvent struct {
+	data *meta.IPAcl
+	op   meta.Operation
+}
+
+type descriptorEvent struct {
+	data *meta.ProtoDescriptor
+	op   meta.Operation
+}
+
 type serviceEvent struct {
 	id   string
 	data *meta.Service
@@ -81,11 +92,15 @@ type storeCache struct {
 	auths    map[string]*meta.Auth
 	routes   map[string]*meta.Route
 	services map[string]*serviceCache
+	ipacls   map[string]*meta.IPAcl
+	descs    map[string]*meta.ProtoDescriptor
 
 	hostCh    chan *hostEvent
 	authCh    chan *authEvent
 	routeCh   chan *routeEvent
 	serviceCh chan *serviceEvent
+	ipaclCh   chan *ipaclEvent
+	descCh    chan *descriptorEvent
 }
 
 func newStoreCache(p *HttpProxy, s store.Store) *storeCache {
@@ -96,10 +111,14 @@ func newStoreCache(p *HttpProxy, s store.Store) *storeCache {
 		auths:     make(map[string]*meta.Auth),
 		routes:    make(map[string]*meta.Route),
 		services:  make(map[string]*serviceCache),
+		ipacls:    make(map[string]*meta.IPAcl),
+		descs:     make(map[string]*meta.ProtoDescriptor),
 		hostCh:    make(chan *hostEvent, 512),
 		authCh:    make(chan *authEvent, 512),
 		routeCh:   make(chan *routeEvent, 1024),
 		serviceCh: make(chan *serviceEvent, 1024),
+		ipaclCh:   make(chan *ipaclEvent, 512),
+		descCh:    make(chan *descriptorEvent, 128),
 	}
 }
 
@@ -162,6 +181,30 @@ func (sc *storeCache) Load() (err error) {
 	if err != nil {
 		return err
 	}
+	err = sc.store.GetIPAcls(func(item *meta.IPAcl) {
+		if item != nil {
+			if err := item.Valid(); err != nil {
+				log.Error("[proxy] invalid ip acl, ", err.Error())
+				return
+			}
+			sc.ipacls[item.Id] = item
+		}
+	})
+	if err != nil {
+		return err
+	}
+	err = sc.store.GetProtoDescriptors(func(item *meta.ProtoDescriptor) {
+		if item != nil {
+			if err := item.Valid(); err != nil {
+				log.Error("[proxy] invalid proto descriptor, ", err.Error())
+				return
+			}
+			sc.descs[item.Id] = item
+		}
+	})
+	if err != nil {
+		return err
+	}
 	for _, s := range sc.services {
 		cfg, err := sc.store.GetServiceCfg(s.Meta.Id)
 		if err != nil {
@@ -201,27 +244,24 @@ func (sc *storeCache) Load() (err error) {
 	}
 	start := time.Now()
 	sc.SyncRuntimeContext()
-	log.Infof("[proxy] build RuntimeContext < cost=%v, hosts=%d, auths=%d, routes=%d, service=%d >",
-		time.Now().Sub(start), len(sc.hosts), len(sc.auths), len(sc.routes), len(sc.services))
+	log.Infof("[proxy] build RuntimeContext < cost=%v, hosts=%d, auths=%d, routes=%d, service=%d, ipacls=%d, descriptors=%d >",
+		time.Now().Sub(start), len(sc.hosts), len(sc.auths), len(sc.routes), len(sc.services), len(sc.ipacls), len(sc.descs))
 	return nil
 }
 
 func (sc *storeCache) SyncRuntimeContext() {
-	hosts := make(map[string]*core.Host)
-	for _, item := range sc.hosts {
-		hosts[item.Value] = core.NewHost(item)
-	}
+	hosts := core.NewHosts(sc.hosts)
 	auths := make(map[string]*core.Auth)
 	for _, item := range sc.auths {
 		auths[item.Id] = core.NewAuth(item)
 	}
 	services := make(map[string]*core.Service)
-	router := sc.MakeRouter()
+	routers := sc.MakeRouters()
 	for _, item := range sc.services {
 		ser := core.NewService(item.Meta)
 		ser.Init(item.Cfg)
 		for _, s := range item.Servers {
-			ser.Servers[s.Id] = core.NewServer(s)
+			ser.Servers[s.Id] = ser.NewServer(s)
 		}
 		ser.ServerList = make([]*core.Server, 0, len(ser.Servers))
 		for _, s := range ser.Servers {
@@ -230,34 +270,51 @@ func (sc *storeCache) SyncRuntimeContext() {
 		for _, a := range item.Apis {
 			ser.Apis[a.Id] = core.NewApi(a, ser)
 		}
+		ser.IndexVersions()
 		services[item.Meta.Id] = ser
 	}
-	sc.pxy.rtCtx.Update(hosts, auths, router, services)
+	sc.pxy.rtCtx.Update(hosts, auths, routers, services, core.NewIPAcls(sc.ipacls), core.NewDescriptors(sc.descs))
 }
 
-func (sc *storeCache) MakeRouter() *router.Router {
-	rt := router.New()
+func (sc *storeCache) MakeRouters() *core.Routers {
+	rts := core.NewRouters()
+	statics := make(map[string]*core.StaticFiles)
+	defer core.SweepStaticFiles(statics)
 	for _, r := range sc.routes {
 		if len(r.Method) <= 0 || len(r.Path) <= 0 {
 			log.Errorf("[proxy] invalid route, method=%s, path=%s", r.Method, r.Path)
 			continue
 		}
+		rt := rts.Global
+		if len(r.HostId) > 0 {
+			rt = rts.Hosts[r.HostId]
+			if rt == nil {
+				if _, ok := sc.hosts[r.HostId]; !ok {
+					log.Warnf("[proxy] host (id=%s) of route %s %s not found", r.HostId, r.Method, r.Path)
+				}
+				rt = router.New()
+				rts.Hosts[r.HostId] = rt
+			}
+		}
 		route := core.NewRoute(r)
+		if route.Static != nil {
+			statics[route.Static.Key()] = route.Static
+		}
 		if r.Method == "*" {
 			for _, m := range router.Methods {
 				if cobrax.Flags.Debug {
-					log.Debugf("[proxy] add route %s, %s", m, r.Path)
+					log.Debugf("[proxy] add route %s, %s %s", m, r.Path, r.HostId)
 				}
 				rt.Add(m, r.Path, route)
 			}
 		} else {
 			if cobrax.Flags.Debug {
-				log.Debugf("[proxy] add route %s, %s", r.Method, r.Path)
+				log.Debugf("[proxy] add route %s, %s %s", r.Method, r.Path, r.HostId)
 			}
 			rt.Add(r.Method, r.Path, route)
 		}
 	}
-	return rt
+	return rts
 }
 
 func (sc *storeCache) DoWatch() (err error) {
@@ -276,24 +333,28 @@ func (sc *storeCache) DoWatch() (err error) {
 }
 
 func (sc *storeCache) RecvHost(op meta.Operation, data *meta.Host) {
+	metrics.StoreEvents.WithLabelValues("host", op.String()).Inc()
 	sc.hostCh <- &hostEvent{
 		op:   op,
 		data: data,
 	}
 }
 func (sc *storeCache) RecvAuth(op meta.Operation, data *meta.Auth) {
+	metrics.StoreEvents.WithLabelValues("auth", op.String()).Inc()
 	sc.authCh <- &authEvent{
 		op:   op,
 		data: data,
 	}
 }
 func (sc *storeCache) RecvRoute(op meta.Operation, data *meta.Route) {
+	metrics.StoreEvents.WithLabelValues("route", op.String()).Inc()
 	sc.routeCh <- &routeEvent{
 		op:   op,
 		data: data,
 	}
 }
 func (sc *storeCache) RecvService(op meta.Operation, data *meta.Service) {
+	metrics.StoreEvents.WithLabelValues("service", op.String()).Inc()
 	sc.serviceCh <- &serviceEvent{
 		op:   op,
 		id:   data.Id,
@@ -301,6 +362,7 @@ func (sc *storeCache) RecvService(op meta.Operation, data *meta.Service) {
 	}
 }
 func (sc *storeCache) RecvServiceConfig(op meta.Operation, service string, data *meta.ServiceConfig) {
+	metrics.StoreEvents.WithLabelValues("service_config", op.String()).Inc()
 	sc.serviceCh <- &serviceEvent{
 		op:  op,
 		id:  service,
@@ -308,6 +370,7 @@ func (sc *storeCache) RecvServiceConfig(op meta.Operation, service string, data
 	}
 }
 func (sc *storeCache) RecvApi(op meta.Operation, service string, data *meta.Api) {
+	metrics.StoreEvents.WithLabelValues("api", op.String()).Inc()
 	sc.serviceCh <- &serviceEvent{
 		op:  op,
 		id:  service,
@@ -315,6 +378,7 @@ func (sc *storeCache) RecvApi(op meta.Operation, service string, data *meta.Api)
 	}
 }
 func (sc *storeCache) RecvServer(op meta.Operation, service string, data *meta.Server) {
+	metrics.StoreEvents.WithLabelValues("server", op.String()).Inc()
 	sc.serviceCh <- &serviceEvent{
 		op:  op,
 		id:  service,
@@ -322,10 +386,33 @@ func (sc *storeCache) RecvServer(op meta.Operation, service string, data *meta.S
 	}
 }
 
+func (sc *storeCache) RecvIPAcl(op meta.Operation, data *meta.IPAcl) {
+	metrics.StoreEvents.WithLabelValues("ipacl", op.String()).Inc()
+	sc.ipaclCh <- &ipaclEvent{
+		op:   op,
+		data: data,
+	}
+}
+
+func (sc *storeCache) RecvProtoDescriptor(op meta.Operation, data *meta.ProtoDescriptor) {
+	metrics.StoreEvents.WithLabelValues("descriptor", op.String()).Inc()
+	sc.descCh <- &descriptorEvent{
+		op:   op,
+		data: data,
+	}
+}
+
+// RecvCachePurge 清除缓存不影响路由等运行时数据，直接处理
+func (sc *storeCache) RecvCachePurge(data *meta.CachePurge) {
+	metrics.StoreEvents.WithLabelValues("cache_purge", meta.OperationCreate.String()).Inc()
+	n := sc.pxy.cache.Purge(data)
+	log.Infof("[proxy] purge cache host=%s, path=%s, api=%s, %d entries", data.Host, data.Path, data.ApiId, n)
+}
+
 func (sc *storeCache) doFetch(stop <-chan struct{}, wg *sync.WaitGroup) {
 	wg.Add(1)
 	defer wg.Done()
-	var hflg, aflg, rflg bool
+	var hflg, aflg, rflg, iflg, dflg bool
 	for {
 		services := make(map[string]*core.Service)
 		select {
@@ -353,6 +440,18 @@ func (sc *storeCache) doFetch(stop <-chan struct{}, wg *sync.WaitGroup) {
 			}
 			sc.updateService(evt)
 			services[evt.id] = nil
+		case evt, ok := <-sc.ipaclCh:
+			if !ok {
+				return
+			}
+			sc.updateIPAcl(evt)
+			iflg = true
+		case evt, ok := <-sc.descCh:
+			if !ok {
+				return
+			}
+			sc.updateDescriptor(evt)
+			dflg = true
 		case <-stop:
 			return
 		}
@@ -384,6 +483,18 @@ func (sc *storeCache) doFetch(stop <-chan struct{}, wg *sync.WaitGroup) {
 				}
 				sc.updateService(evt)
 				services[evt.id] = nil
+			case evt, ok := <-sc.ipaclCh:
+				if !ok {
+					return
+				}
+				sc.updateIPAcl(evt)
+				iflg = true
+			case evt, ok := <-sc.descCh:
+				if !ok {
+					return
+				}
+				sc.updateDescriptor(evt)
+				dflg = true
 			case <-stop:
 				return
 			case <-afterCh:
@@ -391,17 +502,16 @@ func (sc *storeCache) doFetch(stop <-chan struct{}, wg *sync.WaitGroup) {
 			}
 		}
 		var (
-			hosts  core.Hosts
+			hosts  *core.Hosts
 			auths  map[string]*core.Auth
-			routes *router.Router
+			routes *core.Routers
+			ipacls *core.IPAcls
+			descs  *core.Descriptors
 		)
 		rc := sc.pxy.rtCtx
 		start := time.Now()
 		if hflg {
-			hosts = make(core.Hosts)
-			for _, item := range sc.hosts {
-				hosts[item.Value] = core.NewHost(item)
-			}
+			hosts = core.NewHosts(sc.hosts)
 		}
 		if aflg {
 			auths = make(map[string]*core.Auth)
@@ -410,7 +520,13 @@ func (sc *storeCache) doFetch(stop <-chan struct{}, wg *sync.WaitGroup) {
 			}
 		}
 		if rflg {
-			routes = sc.MakeRouter()
+			routes = sc.MakeRouters()
+		}
+		if iflg {
+			ipacls = core.NewIPAcls(sc.ipacls)
+		}
+		if dflg {
+			descs = core.NewDescriptors(sc.descs)
 		}
 		if len(services) > 0 {
 			for id, _ := range services {
@@ -422,7 +538,7 @@ func (sc *storeCache) doFetch(stop <-chan struct{}, wg *sync.WaitGroup) {
 				ser := core.NewService(item.Meta)
 				ser.Init(item.Cfg)
 				for _, s := range item.Servers {
-					ser.Servers[s.Id] = core.NewServer(s)
+					ser.Servers[s.Id] = ser.NewServer(s)
 				}
 				ser.ServerList = make([]*core.Server, 0, len(ser.Servers))
 				for _, s := range ser.Servers {
@@ -433,6 +549,7 @@ func (sc *storeCache) doFetch(stop <-chan struct{}, wg *sync.WaitGroup) {
 				for _, a := range item.Apis {
 					ser.Apis[a.Id] = core.NewApi(a, ser)
 				}
+				ser.IndexVersions()
 				services[id] = ser
 			}
 		}
@@ -445,7 +562,13 @@ func (sc *storeCache) doFetch(stop <-chan struct{}, wg *sync.WaitGroup) {
 			rc.Auths = auths
 		}
 		if rflg {
-			rc.Router = routes
+			rc.Routers = routes
+		}
+		if iflg {
+			rc.IPAcls = ipacls
+		}
+		if dflg {
+			rc.Descriptors = descs
 		}
 		if services != nil {
 			for id, ser := range services {
@@ -487,10 +610,18 @@ func (sc *storeCache) doFetch(stop <-chan struct{}, wg *sync.WaitGroup) {
 		if len(services) > 0 {
 			msg.WriteString("*")
 		}
-		msg.WriteString("service=%d >")
-		log.Infof(msg.String(), time.Now().Sub(start), len(sc.hosts), len(sc.auths), len(sc.routes), len(sc.services))
+		msg.WriteString("service=%d, ")
+		if iflg {
+			msg.WriteString("*")
+		}
+		msg.WriteString("ipacls=%d, ")
+		if dflg {
+			msg.WriteString("*")
+		}
+		msg.WriteString("descriptors=%d >")
+		log.Infof(msg.String(), time.Now().Sub(start), len(sc.hosts), len(sc.auths), len(sc.routes), len(sc.services), len(sc.ipacls), len(sc.descs))
 		services = nil
-		hflg, aflg, rflg = false, false, false
+		hflg, aflg, rflg, iflg, dflg = false, false, false, false, false
 	}
 }
 
@@ -536,6 +667,34 @@ func (sc *storeCache) updateRoute(evt *routeEvent) {
 	}
 }
 
+func (sc *storeCache) updateIPAcl(evt *ipaclEvent) {
+	data := evt.data
+	if data == nil {
+		return
+	}
+	if evt.op == meta.OperationDelete {
+		delete(sc.ipacls, data.Id)
+	} else if err := data.Valid(); err != nil {
+		log.Errorf("invalid ip acl, %s", err.Error())
+	} else if evt.op == meta.OperationUpdate || evt.op == meta.OperationCreate {
+		sc.ipacls[data.Id] = data
+	}
+}
+
+func (sc *storeCache) updateDescriptor(evt *descriptorEvent) {
+	data := evt.data
+	if data == nil {
+		return
+	}
+	if evt.op == meta.OperationDelete {
+		delete(sc.descs, data.Id)
+	} else if err := data.Valid(); err != nil {
+		log.Errorf("invalid proto descriptor, %s", err.Error())
+	} else if evt.op == meta.OperationUpdate || evt.op == meta.OperationCreate {
+		sc.descs[data.Id] = data
+	}
+}
+
 func (sc *storeCache) updateService(evt *serviceEvent) {
 	if evt.api != nil {
 		sc.updateApi(evt.op, evt.id, evt.api)
@@ -0,0 +1,299 @@
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"net/http/httputil"
+	"time"
+
+	"github.com/recallsong/cliframe/cobrax"
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/sogw/proxy/metrics"
+	"github.com/recallsong/sogw/store/meta"
+	log "github.com/sirupsen/logrus"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	DefaultStreamDialTimeout   = 5 * time.Second
+	DefaultStreamHeaderTimeout = 30 * time.Second
+
+	streamBodyKey = "_stream.body"
+)
+
+type StreamConfig struct {
+	// stream chunked responses automatically, requests still go through the server's connection pool,
+	// so the request timeout and the client read timeout also limit the streamed body
+	Auto bool `mapstructure:"auto"`
+	// timeouts of the dedicated server connections used by apis with stream enabled
+	DialTimeout   time.Duration `mapstructure:"dial_timeout"`
+	HeaderTimeout time.Duration `mapstructure:"header_timeout"`
+	// close the stream if no data received from server within this duration, 0 means no limit,
+	// only for apis with stream enabled
+	IdleTimeout time.Duration `mapstructure:"idle_timeout"`
+	// batch continuous data within this duration before flushing, 0 means flush after every read,
+	// event streams are always flushed after every read
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+}
+
+// streamBody 服务器响应的流式body，在交给客户端连接之前释放时关闭服务器连接
+type streamBody struct {
+	conn        net.Conn           // api开启stream时使用的独占连接
+	resp        *fasthttp.Response // 通过连接池转发时持有连接的响应
+	r           io.Reader
+	size        int
+	idleTimeout time.Duration
+	detached    bool
+	eof         bool
+}
+
+func (b *streamBody) Read(p []byte) (int, error) {
+	if b.conn != nil && b.idleTimeout > 0 {
+		b.conn.SetReadDeadline(time.Now().Add(b.idleTimeout))
+	}
+	n, err := b.r.Read(p)
+	if err == io.EOF {
+		b.eof = true
+	}
+	return n, err
+}
+
+func (b *streamBody) Close() error {
+	if b.detached {
+		return nil
+	}
+	return b.release()
+}
+
+// release 关闭独占连接，或者将连接池的连接放回，body没有读取完毕的连接不能复用，直接关闭
+func (b *streamBody) release() error {
+	if b.conn != nil {
+		return b.conn.Close()
+	}
+	if !b.eof {
+		b.resp.SetConnectionClose()
+	}
+	fasthttp.ReleaseResponse(b.resp)
+	return nil
+}
+
+// streamProxy 对需要流式转发的请求只读取响应头部作为ForwardResp，之后在AfterForward时将响应body边读边写给客户端，
+// api开启stream时使用独占连接，auto时通过服务器的连接池转发，响应是chunked时才以流的方式转发
+type streamProxy struct {
+	auto          bool
+	dialTimeout   time.Duration
+	headerTimeout time.Duration
+	idleTimeout   time.Duration
+	flushInterval time.Duration
+}
+
+func newStreamProxy(cfg *StreamConfig) *streamProxy {
+	sp := &streamProxy{
+		auto:          cfg.Auto,
+		dialTimeout:   cfg.DialTimeout,
+		headerTimeout: cfg.HeaderTimeout,
+		idleTimeout:   cfg.IdleTimeout,
+		flushInterval: cfg.FlushInterval,
+	}
+	if sp.dialTimeout <= 0 {
+		sp.dialTimeout = DefaultStreamDialTimeout
+	}
+	if sp.headerTimeout <= 0 {
+		sp.headerTimeout = DefaultStreamHeaderTimeout
+	}
+	return sp
+}
+
+// wantStream 在转发之前判断请求是否走流式转发，auto时响应是否流式转发由forward根据响应头部决定
+func (sp *streamProxy) wantStream(ctx *core.RequestContext) bool {
+	if ctx.ForwardResp != nil || ctx.Server == nil || ctx.ReqCtx.IsHead() ||
+		core.IsWebSocket(&ctx.ReqCtx.Request.Header) || ctx.Server.Meta.Protocol != meta.ServerProtocol_HTTP1 {
+		return false
+	}
+	return ctx.Api.Meta.Stream || sp.auto
+}
+
+func (sp *streamProxy) Start(ctx *core.RequestContext) error {
+	if !sp.wantStream(ctx) {
+		return nil
+	}
+	service, server := ctx.Service.Meta.Name, ctx.Server.Meta.Addr
+	inflight := metrics.RequestsInFlight.WithLabelValues(service, server)
+	inflight.Inc()
+	start := time.Now()
+	var fresp *fasthttp.Response
+	var body *streamBody
+	var err error
+	if ctx.Api.Meta.Stream {
+		fresp, body, err = sp.open(ctx)
+	} else {
+		fresp, body, err = sp.forward(ctx)
+	}
+	ctx.UpstreamCost = time.Since(start)
+	inflight.Dec()
+	if err != nil {
+		metrics.BackendErrors.WithLabelValues(service, server).Inc()
+		log.Errorf("[stream] [%s] forward to %s error : %v", ctx.RequestId, server, err)
+		if forwardBodyTooLarge(ctx, err) {
+			return core.ErrBodyTooLarge
+		}
+		if core.IsTimeout(err) {
+			ctx.WriteErrorWith(fasthttp.StatusGatewayTimeout, core.ErrGatewayTimeout, "")
+			return core.ErrGatewayTimeout
+		}
+		ctx.WriteError(fasthttp.StatusBadGateway)
+		return err
+	}
+	ctx.ForwardResp = fresp
+	if body != nil {
+		ctx.SetAttr(streamBodyKey, body)
+	}
+	return nil
+}
+
+func (sp *streamProxy) End(ctx *core.RequestContext) error {
+	return nil
+}
+
+// open 通过独占连接发送请求并读取响应头部，响应有body时返回streamBody，
+// 请求的超时时间只限制读取响应头部之前的部分
+func (sp *streamProxy) open(ctx *core.RequestContext) (*fasthttp.Response, *streamBody, error) {
+	freq := ctx.ForwardReq
+	deadline := time.Now().Add(sp.headerTimeout)
+	if !ctx.Deadline.IsZero() && ctx.Deadline.Before(deadline) {
+		deadline = ctx.Deadline
+	}
+	dialTimeout := time.Until(deadline)
+	if dialTimeout <= 0 {
+		return nil, nil, fasthttp.ErrTimeout
+	}
+	if dialTimeout > sp.dialTimeout {
+		dialTimeout = sp.dialTimeout
+	}
+	conn, err := ctx.Server.Dial(freq, dialTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	freq.SetConnectionClose()
+	conn.SetDeadline(deadline)
+	bw := bufio.NewWriter(conn)
+	err = freq.Write(bw)
+	if err == nil {
+		err = bw.Flush()
+	}
+	br := bufio.NewReader(conn)
+	fresp := fasthttp.AcquireResponse()
+	for err == nil {
+		err = fresp.Header.Read(br)
+		if err != nil || fresp.StatusCode() != fasthttp.StatusContinue {
+			break
+		}
+	}
+	if err != nil {
+		conn.Close()
+		fasthttp.ReleaseResponse(fresp)
+		return nil, nil, err
+	}
+	conn.SetDeadline(time.Time{})
+	status, size := fresp.StatusCode(), fresp.Header.ContentLength()
+	if status < 200 || status == fasthttp.StatusNoContent || status == fasthttp.StatusNotModified {
+		conn.Close()
+		return fresp, nil, nil
+	}
+	body := &streamBody{conn: conn, size: size, idleTimeout: sp.idleTimeout}
+	switch {
+	case size >= 0:
+		body.r = io.LimitReader(br, int64(size))
+	case size == -1:
+		body.r = httputil.NewChunkedReader(br)
+	default:
+		body.r = br
+	}
+	fresp.SetBodyStream(body, size)
+	return fresp, body, nil
+}
+
+// forward 通过服务器的连接池转发请求，响应是chunked时返回streamBody，它持有连接直到body读取完毕，
+// 否则读取完整的body，连接放回连接池
+func (sp *streamProxy) forward(ctx *core.RequestContext) (*fasthttp.Response, *streamBody, error) {
+	resp := fasthttp.AcquireResponse()
+	resp.StreamBody = true
+	if err := ctx.Server.ForwardDeadline(ctx.ForwardReq, resp, ctx.Deadline); err != nil {
+		fasthttp.ReleaseResponse(resp)
+		return nil, nil, err
+	}
+	if !resp.IsBodyStream() || resp.Header.ContentLength() != -1 {
+		resp.Body()
+		return resp, nil, nil
+	}
+	fresp := fasthttp.AcquireResponse()
+	resp.Header.CopyTo(&fresp.Header)
+	body := &streamBody{resp: resp, r: resp.BodyStream(), size: -1}
+	fresp.SetBodyStream(body, -1)
+	return fresp, body, nil
+}
+
+// finishStream 将服务器响应body以流的方式写给客户端
+func (sp *streamProxy) finishStream(ctx *core.RequestContext) error {
+	body, _ := ctx.Attrs[streamBodyKey].(*streamBody)
+	if body == nil {
+		return nil
+	}
+	body.detached = true
+	resp := &ctx.ReqCtx.Response
+	flushInterval := sp.flushInterval
+	if isEventStream(resp.Header.ContentType()) {
+		flushInterval = 0
+		resp.Header.Set("X-Accel-Buffering", "no")
+	}
+	server, reqId := ctx.Server.Meta.Addr, ctx.RequestId
+	resp.ImmediateHeaderFlush = true
+	resp.SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer body.release()
+		start := time.Now()
+		n, err := copyFlush(w, body, flushInterval)
+		if err != nil {
+			log.Errorf("[stream] [%s] stream from %s error after %d bytes : %v", reqId, server, n, err)
+		} else if cobrax.Flags.Debug {
+			log.Debugf("[stream] [%s] stream from %s finished, %d bytes in %v", reqId, server, n, time.Since(start))
+		}
+	})
+	if body.size >= 0 {
+		resp.Header.SetContentLength(body.size)
+	}
+	return nil
+}
+
+// copyFlush 复制数据并刷新到客户端，flushInterval>0时连续读满缓冲区的数据按间隔合并刷新
+func copyFlush(w *bufio.Writer, r io.Reader, flushInterval time.Duration) (int64, error) {
+	buf := make([]byte, 32*1024)
+	var total int64
+	lastFlush := time.Now()
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			total += int64(n)
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				return total, werr
+			}
+			if flushInterval <= 0 || n < len(buf) || time.Since(lastFlush) >= flushInterval {
+				if ferr := w.Flush(); ferr != nil {
+					return total, ferr
+				}
+				lastFlush = time.Now()
+			}
+		}
+		if err != nil {
+			if err == io.EOF {
+				return total, w.Flush()
+			}
+			return total, err
+		}
+	}
+}
+
+func isEventStream(contentType []byte) bool {
+	return bytes.Contains(bytes.ToLower(contentType), []byte("text/event-stream"))
+}
@@ -0,0 +1,159 @@
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func serveOnce(t *testing.T, resp string) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	go func() {
+		defer ln.Close()
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var req fasthttp.Request
+		if req.Read(bufio.NewReader(conn)) == nil {
+			conn.Write([]byte(resp))
+		}
+	}()
+	return ln.Addr().String()
+}
+
+func newStreamContext(addr string, stream bool) *core.RequestContext {
+	ctx := core.NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.Api = &core.Api{Meta: &meta.Api{Id: "api1", Stream: stream}}
+	ctx.Server = core.NewServer(&meta.Server{Addr: addr})
+	ctx.ForwardReq = &fasthttp.Request{}
+	ctx.ForwardReq.SetRequestURI("/events")
+	return ctx
+}
+
+func TestStreamOpen(t *testing.T) {
+	sp := newStreamProxy(&StreamConfig{})
+	addr := serveOnce(t, "HTTP/1.1 200 OK\r\nContent-Type: text/event-stream\r\nTransfer-Encoding: chunked\r\n\r\n"+
+		"7\r\ndata: a\r\n2\r\n\n\n\r\n0\r\n\r\n")
+	ctx := newStreamContext(addr, true)
+	fresp, body, err := sp.open(ctx)
+	assert.Nil(t, err)
+	assert.NotNil(t, body)
+	assert.True(t, fresp.IsBodyStream())
+	data, err := ioutil.ReadAll(body)
+	assert.Nil(t, err)
+	assert.Equal(t, "data: a\n\n", string(data))
+	body.Close()
+}
+
+func TestStreamForward(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer ln.Close()
+	var conns int32
+	svr := &fasthttp.Server{
+		Handler: func(reqc *fasthttp.RequestCtx) {
+			if string(reqc.Path()) != "/events" {
+				reqc.SetBodyString("hello")
+				return
+			}
+			reqc.SetContentType("text/event-stream")
+			reqc.SetBodyStreamWriter(func(w *bufio.Writer) {
+				w.WriteString("data: a\n\n")
+			})
+		},
+		ConnState: func(conn net.Conn, state fasthttp.ConnState) {
+			if state == fasthttp.StateNew {
+				atomic.AddInt32(&conns, 1)
+			}
+		},
+	}
+	go svr.Serve(ln)
+
+	sp := newStreamProxy(&StreamConfig{Auto: true})
+	ctx := newStreamContext(ln.Addr().String(), false)
+	fresp, body, err := sp.forward(ctx)
+	assert.Nil(t, err)
+	assert.NotNil(t, body)
+	assert.True(t, fresp.IsBodyStream())
+	data, err := ioutil.ReadAll(body)
+	assert.Nil(t, err)
+	assert.Equal(t, "data: a\n\n", string(data))
+	body.Close()
+
+	ctx = newStreamContext(ln.Addr().String(), false)
+	ctx.ForwardReq.SetRequestURI("/data")
+	fresp, body, err = sp.forward(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, body)
+	assert.False(t, fresp.IsBodyStream())
+	assert.Equal(t, "hello", string(fresp.Body()))
+	// the connection is returned to the pool after the stream is read
+	assert.Equal(t, int32(1), atomic.LoadInt32(&conns))
+}
+
+func TestCopyFlush(t *testing.T) {
+	var out bytes.Buffer
+	w := bufio.NewWriterSize(&out, 4096)
+	n, err := copyFlush(w, bytes.NewReader([]byte("data: 1\n\n")), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(9), n)
+	assert.Equal(t, "data: 1\n\n", out.String())
+}
+
+func TestStreamHandler(t *testing.T) {
+	sp := newStreamProxy(&StreamConfig{Auto: true})
+	addr := serveOnce(t, "HTTP/1.1 200 OK\r\nContent-Type: text/plain\r\nTransfer-Encoding: chunked\r\n\r\n"+
+		"5\r\nhello\r\n6\r\n world\r\n0\r\n\r\n")
+	svc := &core.Service{Meta: &meta.Service{Name: "svc"}}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer ln.Close()
+	go fasthttp.Serve(ln, func(reqc *fasthttp.RequestCtx) {
+		ctx := core.NewRequestContext(reqc)
+		defer core.ReleaseRequestContext(ctx)
+		ctx.Service = svc
+		ctx.Api = core.NewApi(&meta.Api{Id: "api1"}, svc)
+		ctx.Server = core.NewServer(&meta.Server{Addr: addr})
+		ctx.ForwardReq = fasthttp.AcquireRequest()
+		reqc.Request.CopyTo(ctx.ForwardReq)
+		if sp.Start(ctx) != nil || finishForward(ctx) != nil {
+			return
+		}
+		sp.finishStream(ctx)
+	})
+	status, body, err := fasthttp.Get(nil, "http://"+ln.Addr().String()+"/data")
+	assert.Nil(t, err)
+	assert.Equal(t, fasthttp.StatusOK, status)
+	assert.Equal(t, "hello world", string(body))
+}
+
+func TestStreamDeadline(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer ln.Close()
+	go func() {
+		conn, err := ln.Accept()
+		if err == nil {
+			time.Sleep(200 * time.Millisecond)
+			conn.Close()
+		}
+	}()
+	sp := newStreamProxy(&StreamConfig{})
+	ctx := newStreamContext(ln.Addr().String(), true)
+	ctx.Service = &core.Service{Meta: &meta.Service{Name: "svc"}}
+	ctx.Deadline = time.Now().Add(50 * time.Millisecond)
+	assert.Equal(t, core.ErrGatewayTimeout, sp.Start(ctx))
+	assert.Equal(t, fasthttp.StatusGatewayTimeout, ctx.ReqCtx.Response.StatusCode())
+}
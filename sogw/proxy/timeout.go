@@ -0,0 +1,57 @@
+package proxy
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/valyala/fasthttp"
+)
+
+type TimeoutConfig struct {
+	// timeout of requests to servers, can be overridden by service and api, 0 means no limit
+	Default time.Duration `mapstructure:"default"`
+	// header to propagate the remaining time in milliseconds to servers, e.g. X-Request-Timeout, disabled if empty
+	Header string `mapstructure:"header"`
+}
+
+// requestTimeout 按照 api > service > 全局 的优先级设置请求的截止时间，从网关收到请求时开始计时
+type requestTimeout struct {
+	def    time.Duration
+	header string
+}
+
+func newRequestTimeout(cfg *TimeoutConfig) *requestTimeout {
+	return &requestTimeout{
+		def:    cfg.Default,
+		header: cfg.Header,
+	}
+}
+
+func (t *requestTimeout) timeoutOf(ctx *core.RequestContext) time.Duration {
+	if ctx.Api != nil && ctx.Api.Meta.Timeout > 0 {
+		return time.Duration(ctx.Api.Meta.Timeout) * time.Millisecond
+	}
+	if ctx.Service != nil && ctx.Service.Config != nil && ctx.Service.Config.Timeout > 0 {
+		return time.Duration(ctx.Service.Config.Timeout) * time.Millisecond
+	}
+	return t.def
+}
+
+// prepare 设置请求的截止时间，并将剩余时间通过header传递给服务器
+func (t *requestTimeout) prepare(ctx *core.RequestContext) error {
+	timeout := t.timeoutOf(ctx)
+	if timeout <= 0 {
+		return nil
+	}
+	ctx.Deadline = ctx.Start.Add(timeout)
+	remain := time.Until(ctx.Deadline)
+	if remain <= 0 {
+		ctx.WriteErrorWith(fasthttp.StatusGatewayTimeout, core.ErrGatewayTimeout, "")
+		return core.ErrGatewayTimeout
+	}
+	if len(t.header) > 0 && ctx.ForwardReq != nil {
+		ctx.ForwardReq.Header.Set(t.header, strconv.FormatInt(remain.Milliseconds(), 10))
+	}
+	return nil
+}
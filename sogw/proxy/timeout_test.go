@@ -0,0 +1,68 @@
+package proxy
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestRequestTimeout(t *testing.T) {
+	rt := newRequestTimeout(&TimeoutConfig{Default: time.Second, Header: "X-Request-Timeout"})
+	svc := &core.Service{Config: &meta.ServiceConfig{Timeout: 2000}}
+	ctx := core.NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.Api = core.NewApi(&meta.Api{Id: "api1"}, svc)
+	ctx.Service = svc
+	ctx.ForwardReq = &fasthttp.Request{}
+	assert.Equal(t, 2*time.Second, rt.timeoutOf(ctx))
+	ctx.Api = core.NewApi(&meta.Api{Id: "api1", Timeout: 500}, svc)
+	assert.Equal(t, 500*time.Millisecond, rt.timeoutOf(ctx))
+	ctx.Service = &core.Service{}
+	ctx.Api = core.NewApi(&meta.Api{Id: "api1"}, ctx.Service)
+	assert.Equal(t, time.Second, rt.timeoutOf(ctx))
+
+	assert.Nil(t, rt.prepare(ctx))
+	assert.Equal(t, ctx.Start.Add(time.Second), ctx.Deadline)
+	assert.NotEmpty(t, string(ctx.ForwardReq.Header.Peek("X-Request-Timeout")))
+
+	ctx.Start = time.Now().Add(-2 * time.Second)
+	assert.Equal(t, core.ErrGatewayTimeout, rt.prepare(ctx))
+	assert.Equal(t, fasthttp.StatusGatewayTimeout, ctx.ReqCtx.Response.StatusCode())
+}
+
+func TestDispatchTimeout(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer ln.Close()
+	go fasthttp.Serve(ln, func(c *fasthttp.RequestCtx) {
+		time.Sleep(200 * time.Millisecond)
+	})
+	ctx := core.NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.Service = &core.Service{Meta: &meta.Service{Name: "svc"}}
+	ctx.Api = core.NewApi(&meta.Api{Id: "api1"}, ctx.Service)
+	ctx.Server = core.NewServer(&meta.Server{Addr: ln.Addr().String()})
+	ctx.ForwardReq = &fasthttp.Request{}
+	ctx.ForwardReq.SetRequestURI("http://example.com/")
+	ctx.Deadline = time.Now().Add(50 * time.Millisecond)
+	assert.Equal(t, core.ErrGatewayTimeout, doDispatch(ctx))
+	assert.Equal(t, fasthttp.StatusGatewayTimeout, ctx.ReqCtx.Response.StatusCode())
+}
+
+func TestDispatchError(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	addr := ln.Addr().String()
+	ln.Close()
+	ctx := core.NewRequestContext(&fasthttp.RequestCtx{})
+	ctx.Service = &core.Service{Meta: &meta.Service{Name: "svc"}}
+	ctx.Api = core.NewApi(&meta.Api{Id: "api1"}, ctx.Service)
+	ctx.Server = core.NewServer(&meta.Server{Addr: addr})
+	ctx.ForwardReq = &fasthttp.Request{}
+	ctx.ForwardReq.SetRequestURI("http://example.com/")
+	assert.NotNil(t, doDispatch(ctx))
+	assert.Equal(t, fasthttp.StatusBadGateway, ctx.ReqCtx.Response.StatusCode())
+}
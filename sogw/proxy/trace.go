@@ -0,0 +1,83 @@
+package proxy
+
+import (
+	"github.com/recallsong/go-utils/reflectx"
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/sogw/proxy/filters"
+	"github.com/recallsong/sogw/sogw/proxy/tracing"
+)
+
+const dispatchSpanKey = "_trace.dispatch"
+
+// traceStep 为处理步骤创建子span
+func traceStep(name string, step filters.HookFunc) filters.HookFunc {
+	return func(ctx *core.RequestContext) error {
+		if ctx.Span == nil {
+			return step(ctx)
+		}
+		span := ctx.Span.StartChild(name, tracing.SpanKindInternal)
+		err := step(ctx)
+		if err != filters.ErrExit {
+			span.SetError(err)
+		}
+		span.Finish()
+		return err
+	}
+}
+
+// dispatchTracer 记录发送后端请求的span，并向后端传递链路信息
+type dispatchTracer struct{}
+
+func (dispatchTracer) Start(ctx *core.RequestContext) error {
+	if ctx.Span == nil || ctx.ForwardResp != nil {
+		return nil
+	}
+	span := ctx.Span.StartChild("dispatch", tracing.SpanKindClient)
+	if ctx.Server != nil {
+		span.SetAttr("server.address", ctx.Server.Meta.Addr)
+	}
+	if ctx.ForwardReq != nil {
+		span.SetAttr("url.full", reflectx.BytesToString(ctx.ForwardReq.URI().FullURI()))
+		span.Inject(&ctx.ForwardReq.Header)
+	}
+	ctx.SetAttr(dispatchSpanKey, span)
+	return nil
+}
+
+func (dispatchTracer) End(ctx *core.RequestContext) error {
+	span, _ := ctx.Attrs[dispatchSpanKey].(*tracing.Span)
+	if span == nil {
+		return nil
+	}
+	if ctx.ForwardResp != nil {
+		span.SetAttr("http.response.status_code", ctx.ForwardResp.StatusCode())
+	}
+	span.SetError(ctx.Err)
+	span.Finish()
+	return nil
+}
+
+func finishTrace(ctx *core.RequestContext) {
+	span := ctx.Span
+	if span == nil {
+		return
+	}
+	reqc := ctx.ReqCtx
+	span.SetAttr("http.request.method", string(reqc.Method()))
+	span.SetAttr("url.path", string(reqc.Path()))
+	span.SetAttr("server.address", string(reqc.Host()))
+	span.SetAttr("client.address", ctx.GetRealClientAddr())
+	span.SetAttr("sogw.request_id", ctx.RequestId)
+	span.SetAttr("http.response.status_code", reqc.Response.StatusCode())
+	if ctx.Route != nil {
+		span.SetAttr("http.route", ctx.Route.Meta.Path)
+	}
+	if ctx.Service != nil {
+		span.SetAttr("sogw.service", ctx.Service.Meta.Name)
+	}
+	if ctx.Api != nil {
+		span.SetAttr("sogw.api", ctx.Api.Meta.Id)
+	}
+	span.SetError(ctx.Err)
+	span.Finish()
+}
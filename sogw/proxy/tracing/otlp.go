@@ -0,0 +1,193 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	otlpBatchSize     = 512
+	otlpQueueSize     = 4096
+	otlpFlushInterval = 5 * time.Second
+)
+
+// OTLPExporter 以OTLP/HTTP JSON格式批量发送span到collector
+type OTLPExporter struct {
+	endpoint    string
+	serviceName string
+	client      *http.Client
+	queue       chan *Span
+	closeCh     chan struct{}
+	wg          sync.WaitGroup
+}
+
+func NewOTLPExporter(endpoint, serviceName string) *OTLPExporter {
+	if len(serviceName) <= 0 {
+		serviceName = "sogw"
+	}
+	e := &OTLPExporter{
+		endpoint:    endpoint,
+		serviceName: serviceName,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		queue:       make(chan *Span, otlpQueueSize),
+		closeCh:     make(chan struct{}),
+	}
+	e.wg.Add(1)
+	go e.run()
+	return e
+}
+
+func (e *OTLPExporter) Export(span *Span) {
+	select {
+	case e.queue <- span:
+	default:
+		log.Warn("[tracing] span queue is full, drop span ", span.Name)
+	}
+}
+
+func (e *OTLPExporter) Close() error {
+	close(e.closeCh)
+	e.wg.Wait()
+	return nil
+}
+
+func (e *OTLPExporter) run() {
+	defer e.wg.Done()
+	ticker := time.NewTicker(otlpFlushInterval)
+	defer ticker.Stop()
+	batch := make([]*Span, 0, otlpBatchSize)
+	flush := func() {
+		if len(batch) > 0 {
+			if err := e.send(batch); err != nil {
+				log.Errorf("[tracing] export %d spans to %s error : %v", len(batch), e.endpoint, err)
+			}
+			batch = batch[:0]
+		}
+	}
+	for {
+		select {
+		case span := <-e.queue:
+			batch = append(batch, span)
+			if len(batch) >= otlpBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-e.closeCh:
+			for {
+				select {
+				case span := <-e.queue:
+					batch = append(batch, span)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (e *OTLPExporter) send(spans []*Span) error {
+	body, err := json.Marshal(e.encode(spans))
+	if err != nil {
+		return err
+	}
+	resp, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("collector response status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+type otlpKeyValue struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	TraceState        string         `json:"traceState,omitempty"`
+	Name              string         `json:"name"`
+	Kind              SpanKind       `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+func (e *OTLPExporter) encode(spans []*Span) map[string]interface{} {
+	list := make([]*otlpSpan, len(spans))
+	for i, s := range spans {
+		item := &otlpSpan{
+			TraceID:           s.Context.TraceID.String(),
+			SpanID:            s.Context.SpanID.String(),
+			TraceState:        s.Context.TraceState,
+			Name:              s.Name,
+			Kind:              s.Kind,
+			StartTimeUnixNano: strconv.FormatInt(s.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.End.UnixNano(), 10),
+		}
+		if s.Parent.IsValid() {
+			item.ParentSpanID = s.Parent.String()
+		}
+		for k, v := range s.Attrs {
+			item.Attributes = append(item.Attributes, otlpKeyValue{Key: k, Value: otlpValue(v)})
+		}
+		if len(s.Err) > 0 {
+			item.Status = otlpStatus{Code: 2, Message: s.Err}
+		}
+		list[i] = item
+	}
+	return map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": []otlpKeyValue{
+						{Key: "service.name", Value: otlpValue(e.serviceName)},
+					},
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]interface{}{"name": "sogw"},
+						"spans": list,
+					},
+				},
+			},
+		},
+	}
+}
+
+func otlpValue(v interface{}) map[string]interface{} {
+	switch val := v.(type) {
+	case string:
+		return map[string]interface{}{"stringValue": val}
+	case bool:
+		return map[string]interface{}{"boolValue": val}
+	case int:
+		return map[string]interface{}{"intValue": strconv.Itoa(val)}
+	case int64:
+		return map[string]interface{}{"intValue": strconv.FormatInt(val, 10)}
+	case float64:
+		return map[string]interface{}{"doubleValue": val}
+	default:
+		return map[string]interface{}{"stringValue": fmt.Sprint(val)}
+	}
+}
@@ -0,0 +1,134 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+type TraceID [16]byte
+type SpanID [8]byte
+
+func (t TraceID) IsValid() bool  { return t != TraceID{} }
+func (t TraceID) String() string { return hex.EncodeToString(t[:]) }
+func (s SpanID) IsValid() bool   { return s != SpanID{} }
+func (s SpanID) String() string  { return hex.EncodeToString(s[:]) }
+
+// SpanContext 跨进程传递的链路信息
+type SpanContext struct {
+	TraceID    TraceID
+	SpanID     SpanID
+	Sampled    bool
+	TraceState string
+}
+
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+// Header 请求头的读写接口，fasthttp.RequestHeader 实现了该接口
+type Header interface {
+	Peek(key string) []byte
+	Set(key, value string)
+	Del(key string)
+}
+
+// Extract 从请求头中解析链路信息，优先使用W3C traceparent，其次是B3
+func Extract(h Header) (SpanContext, bool) {
+	if sc, ok := ParseTraceparent(string(h.Peek("traceparent"))); ok {
+		sc.TraceState = string(h.Peek("tracestate"))
+		return sc, true
+	}
+	if sc, ok := ParseB3Single(string(h.Peek("b3"))); ok {
+		return sc, true
+	}
+	return parseB3Multi(h)
+}
+
+// Inject 将链路信息写入请求头
+func Inject(sc SpanContext, h Header, b3 bool) {
+	h.Set("traceparent", FormatTraceparent(sc))
+	if len(sc.TraceState) > 0 {
+		h.Set("tracestate", sc.TraceState)
+	} else {
+		h.Del("tracestate")
+	}
+	if b3 {
+		h.Set("X-B3-TraceId", sc.TraceID.String())
+		h.Set("X-B3-SpanId", sc.SpanID.String())
+		h.Del("X-B3-ParentSpanId")
+		if sc.Sampled {
+			h.Set("X-B3-Sampled", "1")
+		} else {
+			h.Set("X-B3-Sampled", "0")
+		}
+		h.Del("b3")
+	}
+}
+
+// ParseTraceparent 解析格式：version-traceid-spanid-flags，例如：00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01
+func ParseTraceparent(s string) (sc SpanContext, ok bool) {
+	s = strings.TrimSpace(s)
+	if len(s) < 55 || s[2] != '-' || s[35] != '-' || s[52] != '-' {
+		return sc, false
+	}
+	ver, err := hex.DecodeString(s[0:2])
+	if err != nil || ver[0] == 0xff || (ver[0] == 0 && len(s) != 55) {
+		return sc, false
+	}
+	if !decodeHex(sc.TraceID[:], s[3:35]) || !decodeHex(sc.SpanID[:], s[36:52]) {
+		return sc, false
+	}
+	flags, err := hex.DecodeString(s[53:55])
+	if err != nil || !sc.IsValid() {
+		return sc, false
+	}
+	sc.Sampled = flags[0]&0x01 == 1
+	return sc, true
+}
+
+func FormatTraceparent(sc SpanContext) string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return "00-" + sc.TraceID.String() + "-" + sc.SpanID.String() + "-" + flags
+}
+
+// ParseB3Single 解析格式：traceid-spanid-sampled-parentspanid，后两项可选
+func ParseB3Single(s string) (sc SpanContext, ok bool) {
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) < 2 {
+		return sc, false
+	}
+	if !decodeB3TraceID(&sc.TraceID, parts[0]) || !decodeHex(sc.SpanID[:], parts[1]) {
+		return sc, false
+	}
+	sc.Sampled = len(parts) < 3 || parts[2] == "1" || parts[2] == "d"
+	return sc, sc.IsValid()
+}
+
+func parseB3Multi(h Header) (sc SpanContext, ok bool) {
+	if !decodeB3TraceID(&sc.TraceID, string(h.Peek("X-B3-TraceId"))) ||
+		!decodeHex(sc.SpanID[:], string(h.Peek("X-B3-SpanId"))) {
+		return sc, false
+	}
+	sampled := string(h.Peek("X-B3-Sampled"))
+	sc.Sampled = sampled != "0" && sampled != "false"
+	return sc, sc.IsValid()
+}
+
+// decodeB3TraceID B3的traceid可以是64位或128位
+func decodeB3TraceID(t *TraceID, s string) bool {
+	if len(s) == 16 {
+		return decodeHex(t[8:], s)
+	}
+	return decodeHex(t[:], s)
+}
+
+func decodeHex(dst []byte, s string) bool {
+	if len(s) != len(dst)*2 {
+		return false
+	}
+	_, err := hex.Decode(dst, []byte(s))
+	return err == nil
+}
@@ -0,0 +1,160 @@
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	mrand "math/rand"
+	"sync"
+	"time"
+)
+
+type Config struct {
+	// OTLP/HTTP traces endpoint, e.g. http://localhost:4318/v1/traces
+	Endpoint    string  `mapstructure:"endpoint"`
+	ServiceName string  `mapstructure:"service_name"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+	B3          bool    `mapstructure:"b3"`
+}
+
+type SpanKind int8
+
+const (
+	SpanKindInternal = SpanKind(1)
+	SpanKindServer   = SpanKind(2)
+	SpanKindClient   = SpanKind(3)
+)
+
+// Exporter 导出已结束的span
+type Exporter interface {
+	Export(span *Span)
+	Close() error
+}
+
+type Tracer struct {
+	exporter Exporter
+	ratio    float64
+	b3       bool
+	rnd      *mrand.Rand
+	lock     sync.Mutex
+}
+
+func NewTracer(cfg *Config, exporter Exporter) *Tracer {
+	ratio := cfg.SampleRatio
+	if ratio <= 0 || ratio > 1 {
+		ratio = 1
+	}
+	var seed int64
+	binary.Read(rand.Reader, binary.LittleEndian, &seed)
+	return &Tracer{
+		exporter: exporter,
+		ratio:    ratio,
+		b3:       cfg.B3,
+		rnd:      mrand.New(mrand.NewSource(seed)),
+	}
+}
+
+// StartServerSpan 开始或者继续请求头中的链路
+func (t *Tracer) StartServerSpan(name string, h Header) *Span {
+	if t == nil {
+		return nil
+	}
+	parent, ok := Extract(h)
+	if !ok {
+		return t.startSpan(name, SpanKindServer, SpanContext{}, t.sample())
+	}
+	return t.startSpan(name, SpanKindServer, parent, parent.Sampled)
+}
+
+func (t *Tracer) Close() error {
+	if t == nil {
+		return nil
+	}
+	return t.exporter.Close()
+}
+
+func (t *Tracer) startSpan(name string, kind SpanKind, parent SpanContext, sampled bool) *Span {
+	s := &Span{
+		tracer: t,
+		Name:   name,
+		Kind:   kind,
+		Start:  time.Now(),
+		Parent: parent.SpanID,
+	}
+	s.Context.TraceState = parent.TraceState
+	s.Context.Sampled = sampled
+	t.lock.Lock()
+	if parent.TraceID.IsValid() {
+		s.Context.TraceID = parent.TraceID
+	} else {
+		t.rnd.Read(s.Context.TraceID[:])
+	}
+	t.rnd.Read(s.Context.SpanID[:])
+	t.lock.Unlock()
+	return s
+}
+
+func (t *Tracer) sample() bool {
+	if t.ratio >= 1 {
+		return true
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.rnd.Float64() < t.ratio
+}
+
+type Span struct {
+	tracer  *Tracer
+	Name    string
+	Kind    SpanKind
+	Context SpanContext
+	Parent  SpanID
+	Start   time.Time
+	End     time.Time
+	Attrs   map[string]interface{}
+	Err     string
+	ended   bool
+}
+
+// StartChild 创建子span，Span为nil时返回nil
+func (s *Span) StartChild(name string, kind SpanKind) *Span {
+	if s == nil {
+		return nil
+	}
+	return s.tracer.startSpan(name, kind, s.Context, s.Context.Sampled)
+}
+
+func (s *Span) SetAttr(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	if s.Attrs == nil {
+		s.Attrs = make(map[string]interface{})
+	}
+	s.Attrs[key] = value
+}
+
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.Err = err.Error()
+}
+
+// Inject 将当前span的链路信息写入请求头
+func (s *Span) Inject(h Header) {
+	if s == nil {
+		return
+	}
+	Inject(s.Context, h, s.tracer.b3)
+}
+
+func (s *Span) Finish() {
+	if s == nil || s.ended {
+		return
+	}
+	s.ended = true
+	s.End = time.Now()
+	if s.Context.Sampled && s.tracer.exporter != nil {
+		s.tracer.exporter.Export(s)
+	}
+}
@@ -0,0 +1,100 @@
+package tracing
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestTraceparent(t *testing.T) {
+	sc, ok := ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	assert.True(t, ok)
+	assert.True(t, sc.Sampled)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID.String())
+	assert.Equal(t, "00f067aa0ba902b7", sc.SpanID.String())
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", FormatTraceparent(sc))
+
+	for _, s := range []string{
+		"",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"00-4bf92f3577b34da6a3ce929d0e0e473x-00f067aa0ba902b7-01",
+	} {
+		_, ok := ParseTraceparent(s)
+		assert.False(t, ok, s)
+	}
+}
+
+func TestExtractInject(t *testing.T) {
+	var h fasthttp.RequestHeader
+	h.Set("X-B3-TraceId", "463ac35c9f6413ad")
+	h.Set("X-B3-SpanId", "a2fb4a1d1a96d312")
+	h.Set("X-B3-Sampled", "0")
+	sc, ok := Extract(&h)
+	assert.True(t, ok)
+	assert.False(t, sc.Sampled)
+	assert.Equal(t, "0000000000000000463ac35c9f6413ad", sc.TraceID.String())
+
+	h.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	h.Set("tracestate", "congo=t61rcWkgMzE")
+	sc, ok = Extract(&h)
+	assert.True(t, ok)
+	assert.True(t, sc.Sampled)
+	assert.Equal(t, "congo=t61rcWkgMzE", sc.TraceState)
+
+	var out fasthttp.RequestHeader
+	Inject(sc, &out, true)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", string(out.Peek("traceparent")))
+	assert.Equal(t, "congo=t61rcWkgMzE", string(out.Peek("tracestate")))
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", string(out.Peek("X-B3-TraceId")))
+	assert.Equal(t, "1", string(out.Peek("X-B3-Sampled")))
+}
+
+func TestOTLPExporter(t *testing.T) {
+	bodies := make(chan map[string]interface{}, 1)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		var data map[string]interface{}
+		json.Unmarshal(body, &data)
+		bodies <- data
+	}))
+	defer collector.Close()
+
+	tracer := NewTracer(&Config{}, NewOTLPExporter(collector.URL, "test"))
+	var h fasthttp.RequestHeader
+	h.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	root := tracer.StartServerSpan("HTTP GET", &h)
+	child := root.StartChild("dispatch", SpanKindClient)
+	child.SetAttr("server.address", "localhost:7001")
+	child.Finish()
+	root.Finish()
+	tracer.Close()
+
+	data := <-bodies
+	rs := data["resourceSpans"].([]interface{})[0].(map[string]interface{})
+	spans := rs["scopeSpans"].([]interface{})[0].(map[string]interface{})["spans"].([]interface{})
+	assert.Equal(t, 2, len(spans))
+	first := spans[0].(map[string]interface{})
+	second := spans[1].(map[string]interface{})
+	assert.Equal(t, "dispatch", first["name"])
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", first["traceId"])
+	assert.Equal(t, second["spanId"], first["parentSpanId"])
+	assert.Equal(t, "00f067aa0ba902b7", second["parentSpanId"])
+}
+
+func TestNilSpan(t *testing.T) {
+	var tracer *Tracer
+	span := tracer.StartServerSpan("HTTP GET", &fasthttp.RequestHeader{})
+	assert.Nil(t, span)
+	child := span.StartChild("route", SpanKindInternal)
+	child.SetAttr("k", "v")
+	child.Finish()
+	assert.Nil(t, tracer.Close())
+}
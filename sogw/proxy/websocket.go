@@ -0,0 +1,179 @@
+package proxy
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/recallsong/cliframe/cobrax"
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/sogw/proxy/metrics"
+	log "github.com/sirupsen/logrus"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	DefaultWebSocketDialTimeout = 5 * time.Second
+	DefaultWebSocketIdleTimeout = 5 * time.Minute
+
+	upgradeConnKey = "_websocket.conn"
+)
+
+type WebSocketConfig struct {
+	DialTimeout time.Duration `mapstructure:"dial_timeout"`
+	// close the connection if no data in both directions within this duration
+	IdleTimeout time.Duration `mapstructure:"idle_timeout"`
+}
+
+// upgradeConn 已经完成握手的服务器连接，br中可能缓存了服务器在握手之后发送的数据
+type upgradeConn struct {
+	conn     net.Conn
+	br       *bufio.Reader
+	hijacked bool
+}
+
+// webSocketProxy 在BeforeDispatch时与服务器完成WebSocket握手，握手的响应作为ForwardResp，
+// 之后在AfterForward时接管客户端连接并双向转发数据
+type webSocketProxy struct {
+	dialTimeout time.Duration
+	idleTimeout time.Duration
+}
+
+func newWebSocketProxy(cfg *WebSocketConfig) *webSocketProxy {
+	ws := &webSocketProxy{
+		dialTimeout: cfg.DialTimeout,
+		idleTimeout: cfg.IdleTimeout,
+	}
+	if ws.dialTimeout <= 0 {
+		ws.dialTimeout = DefaultWebSocketDialTimeout
+	}
+	if ws.idleTimeout <= 0 {
+		ws.idleTimeout = DefaultWebSocketIdleTimeout
+	}
+	return ws
+}
+
+func (ws *webSocketProxy) Start(ctx *core.RequestContext) error {
+	if ctx.ForwardResp != nil || ctx.Server == nil || !core.IsWebSocket(&ctx.ReqCtx.Request.Header) {
+		return nil
+	}
+	conn, err := ctx.Server.Dial(ctx.ForwardReq, ws.dialTimeout)
+	if err != nil {
+		metrics.BackendErrors.WithLabelValues(ctx.Service.Meta.Name, ctx.Server.Meta.Addr).Inc()
+		log.Errorf("[websocket] [%s] dial %s error : %v", ctx.RequestId, ctx.Server.Meta.Addr, err)
+		ctx.WriteError(fasthttp.StatusBadGateway)
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(ws.dialTimeout))
+	bw := bufio.NewWriter(conn)
+	err = ctx.ForwardReq.Write(bw)
+	if err == nil {
+		err = bw.Flush()
+	}
+	br := bufio.NewReader(conn)
+	fresp := fasthttp.AcquireResponse()
+	if err == nil {
+		err = fresp.Read(br)
+	}
+	if err != nil {
+		conn.Close()
+		fasthttp.ReleaseResponse(fresp)
+		metrics.BackendErrors.WithLabelValues(ctx.Service.Meta.Name, ctx.Server.Meta.Addr).Inc()
+		log.Errorf("[websocket] [%s] handshake with %s error : %v", ctx.RequestId, ctx.Server.Meta.Addr, err)
+		ctx.WriteError(fasthttp.StatusBadGateway)
+		return err
+	}
+	conn.SetDeadline(time.Time{})
+	ctx.ForwardResp = fresp
+	if fresp.StatusCode() != fasthttp.StatusSwitchingProtocols {
+		conn.Close()
+		return nil
+	}
+	ctx.SetAttr(upgradeConnKey, &upgradeConn{conn: conn, br: br})
+	return nil
+}
+
+func (ws *webSocketProxy) End(ctx *core.RequestContext) error {
+	return nil
+}
+
+// finishUpgrade 恢复被删除的升级头部，并在响应发送后接管客户端连接
+func (ws *webSocketProxy) finishUpgrade(ctx *core.RequestContext) error {
+	uc, _ := ctx.Attrs[upgradeConnKey].(*upgradeConn)
+	if uc == nil {
+		return nil
+	}
+	resp := &ctx.ReqCtx.Response
+	resp.Header.Set("Connection", "Upgrade")
+	resp.Header.Set("Upgrade", "websocket")
+	uc.hijacked = true
+	service, server, reqId := ctx.Service.Meta.Name, ctx.Server.Meta.Addr, ctx.RequestId
+	ctx.ReqCtx.Hijack(func(client net.Conn) {
+		gauge := metrics.WebSocketConnections.WithLabelValues(service, server)
+		gauge.Inc()
+		defer gauge.Dec()
+		start := time.Now()
+		err := ws.pipe(client, uc)
+		if cobrax.Flags.Debug {
+			log.Debugf("[websocket] [%s] connection to %s closed after %v : %v", reqId, server, time.Since(start), err)
+		}
+	})
+	return nil
+}
+
+// pipe 双向转发数据，任意一端关闭或者空闲超时后关闭两端的连接
+func (ws *webSocketProxy) pipe(client net.Conn, uc *upgradeConn) error {
+	var lastActive int64
+	touch := func() { atomic.StoreInt64(&lastActive, time.Now().UnixNano()) }
+	touch()
+	var once sync.Once
+	var result error
+	closeAll := func(err error) {
+		once.Do(func() {
+			result = err
+			client.Close()
+			uc.conn.Close()
+		})
+	}
+	copyConn := func(dst net.Conn, src io.Reader, srcConn net.Conn) {
+		buf := make([]byte, 32*1024)
+		for {
+			srcConn.SetReadDeadline(time.Now().Add(ws.idleTimeout))
+			n, err := src.Read(buf)
+			if n > 0 {
+				touch()
+				if _, werr := dst.Write(buf[:n]); werr != nil {
+					closeAll(werr)
+					return
+				}
+			}
+			if err != nil {
+				if ne, ok := err.(net.Error); ok && ne.Timeout() {
+					idle := time.Duration(time.Now().UnixNano() - atomic.LoadInt64(&lastActive))
+					if idle < ws.idleTimeout {
+						continue
+					}
+				}
+				closeAll(err)
+				return
+			}
+		}
+	}
+	done := make(chan struct{})
+	go func() {
+		copyConn(client, uc.br, uc.conn)
+		close(done)
+	}()
+	copyConn(uc.conn, client, client)
+	<-done
+	return result
+}
+
+func releaseUpgrade(ctx *core.RequestContext) {
+	if uc, _ := ctx.Attrs[upgradeConnKey].(*upgradeConn); uc != nil && !uc.hijacked {
+		uc.conn.Close()
+	}
+}
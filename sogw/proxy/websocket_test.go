@@ -0,0 +1,120 @@
+package proxy
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/recallsong/sogw/sogw/proxy/core"
+	"github.com/recallsong/sogw/store/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+// serveWebSocket 模拟服务器，返回status作为握手响应，握手成功后原样返回收到的数据，closed在连接关闭时被关闭
+func serveWebSocket(t *testing.T, status int) (string, chan struct{}) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	closed := make(chan struct{})
+	go func() {
+		defer ln.Close()
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer close(closed)
+		defer conn.Close()
+		br := bufio.NewReader(conn)
+		var req fasthttp.Request
+		if req.Read(br) != nil || !core.IsWebSocket(&req.Header) {
+			return
+		}
+		if status != fasthttp.StatusSwitchingProtocols {
+			conn.Write([]byte("HTTP/1.1 403 Forbidden\r\nContent-Length: 0\r\n\r\n"))
+			io.Copy(ioutil.Discard, br)
+			return
+		}
+		conn.Write([]byte("HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: websocket\r\n\r\n"))
+		io.Copy(conn, br)
+	}()
+	return ln.Addr().String(), closed
+}
+
+// serveWebSocketProxy 启动只包含WebSocket转发步骤的代理
+func serveWebSocketProxy(t *testing.T, addr string) net.Listener {
+	ws := newWebSocketProxy(&WebSocketConfig{})
+	svc := &core.Service{Meta: &meta.Service{Name: "svc"}}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	go fasthttp.Serve(ln, func(reqc *fasthttp.RequestCtx) {
+		ctx := core.NewRequestContext(reqc)
+		defer core.ReleaseRequestContext(ctx)
+		defer releaseUpgrade(ctx)
+		ctx.Service = svc
+		ctx.Api = core.NewApi(&meta.Api{Id: "api1"}, svc)
+		ctx.Server = core.NewServer(&meta.Server{Addr: addr})
+		freq := fasthttp.AcquireRequest()
+		reqc.Request.CopyTo(freq)
+		core.RemoveHopHeaders(&freq.Header)
+		freq.Header.Set("Connection", "Upgrade")
+		freq.Header.Set("Upgrade", "websocket")
+		ctx.ForwardReq = freq
+		if ws.Start(ctx) != nil || finishForward(ctx) != nil {
+			return
+		}
+		ws.finishUpgrade(ctx)
+	})
+	return ln
+}
+
+func dialWebSocket(t *testing.T, addr string) (net.Conn, *bufio.Reader, int) {
+	conn, err := net.Dial("tcp", addr)
+	assert.Nil(t, err)
+	conn.SetDeadline(time.Now().Add(3 * time.Second))
+	_, err = conn.Write([]byte("GET /ws HTTP/1.1\r\nHost: example.com\r\nConnection: Upgrade\r\nUpgrade: websocket\r\n" +
+		"Sec-WebSocket-Version: 13\r\nSec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n\r\n"))
+	assert.Nil(t, err)
+	br := bufio.NewReader(conn)
+	var header fasthttp.ResponseHeader
+	assert.Nil(t, header.Read(br))
+	return conn, br, header.StatusCode()
+}
+
+func TestWebSocketProxy(t *testing.T) {
+	addr, closed := serveWebSocket(t, fasthttp.StatusSwitchingProtocols)
+	ln := serveWebSocketProxy(t, addr)
+	defer ln.Close()
+	conn, br, status := dialWebSocket(t, ln.Addr().String())
+	assert.Equal(t, fasthttp.StatusSwitchingProtocols, status)
+
+	_, err := conn.Write([]byte("ping"))
+	assert.Nil(t, err)
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(br, buf)
+	assert.Nil(t, err)
+	assert.Equal(t, "ping", string(buf))
+
+	conn.Close()
+	select {
+	case <-closed:
+	case <-time.After(3 * time.Second):
+		t.Fatal("server connection is not closed")
+	}
+}
+
+func TestWebSocketRefused(t *testing.T) {
+	addr, closed := serveWebSocket(t, fasthttp.StatusForbidden)
+	ln := serveWebSocketProxy(t, addr)
+	defer ln.Close()
+	conn, _, status := dialWebSocket(t, ln.Addr().String())
+	defer conn.Close()
+	assert.Equal(t, fasthttp.StatusForbidden, status)
+	select {
+	case <-closed:
+	case <-time.After(3 * time.Second):
+		t.Fatal("server connection is not closed")
+	}
+}
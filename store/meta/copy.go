@@ -2,6 +2,12 @@ package meta
 
 func (h *Host) Copy() *Host {
 	val := *h
+	if h.ErrorPages != nil {
+		val.ErrorPages = h.ErrorPages.Copy()
+	}
+	if h.Cors != nil {
+		val.Cors = h.Cors.Copy()
+	}
 	return &val
 }
 
@@ -35,6 +41,17 @@ func (r *Route) Copy() *Route {
 		}
 		val.ApiConds = conds
 	}
+	if r.Split != nil {
+		val.Split = r.Split.Copy()
+	}
+	if r.Mirror != nil {
+		mirror := *r.Mirror
+		val.Mirror = &mirror
+	}
+	if r.Versioning != nil {
+		versioning := *r.Versioning
+		val.Versioning = &versioning
+	}
 	return &val
 }
 
@@ -103,6 +120,27 @@ func (a *Api) Copy() *Api {
 		}
 		val.Validators = valids
 	}
+	if a.ErrorPages != nil {
+		val.ErrorPages = a.ErrorPages.Copy()
+	}
+	if a.Cache != nil {
+		val.Cache = a.Cache.Copy()
+	}
+	if a.Cors != nil {
+		val.Cors = a.Cors.Copy()
+	}
+	if a.Grpc != nil {
+		grpc := *a.Grpc
+		val.Grpc = &grpc
+	}
+	if a.Mirror != nil {
+		mirror := *a.Mirror
+		val.Mirror = &mirror
+	}
+	if a.Deprecation != nil {
+		deprecation := *a.Deprecation
+		val.Deprecation = &deprecation
+	}
 	return &val
 }
 
@@ -112,6 +150,10 @@ func (s *Server) Copy() *Server {
 		hc := *s.HealthCheck
 		val.HealthCheck = &hc
 	}
+	if s.Client != nil {
+		client := *s.Client
+		val.Client = &client
+	}
 	return &val
 }
 func (c *ServiceConfig) Copy() *ServiceConfig {
@@ -124,6 +166,20 @@ func (c *ServiceConfig) Copy() *ServiceConfig {
 		}
 		val.Context = ctx
 	}
+	if c.ProxyHeaders != nil {
+		ph := *c.ProxyHeaders
+		val.ProxyHeaders = &ph
+	}
+	if c.ErrorPages != nil {
+		val.ErrorPages = c.ErrorPages.Copy()
+	}
+	if c.Cors != nil {
+		val.Cors = c.Cors.Copy()
+	}
+	if c.Client != nil {
+		client := *c.Client
+		val.Client = &client
+	}
 	return &val
 }
 
@@ -138,3 +194,70 @@ func (g *Gateway) Copy() *Gateway {
 	}
 	return &val
 }
+
+func (a *IPAcl) Copy() *IPAcl {
+	val := *a
+	if a.Cidrs != nil {
+		cidrs := make([]string, len(a.Cidrs))
+		copy(cidrs, a.Cidrs)
+		val.Cidrs = cidrs
+	}
+	return &val
+}
+
+func (e *ErrorPages) Copy() *ErrorPages {
+	val := *e
+	if e.Bodies != nil {
+		bodies := make(map[string]string)
+		for k, v := range e.Bodies {
+			bodies[k] = v
+		}
+		val.Bodies = bodies
+	}
+	return &val
+}
+
+func (c *CacheConfig) Copy() *CacheConfig {
+	val := *c
+	if c.Keys != nil {
+		keys := make([]*ValueItem, len(c.Keys))
+		for i, v := range c.Keys {
+			val := *v
+			keys[i] = &val
+		}
+		val.Keys = keys
+	}
+	return &val
+}
+
+func (c *CorsPolicy) Copy() *CorsPolicy {
+	val := *c
+	val.AllowOrigins = append([]string(nil), c.AllowOrigins...)
+	val.AllowMethods = append([]string(nil), c.AllowMethods...)
+	val.AllowHeaders = append([]string(nil), c.AllowHeaders...)
+	val.ExposeHeaders = append([]string(nil), c.ExposeHeaders...)
+	return &val
+}
+
+func (s *TrafficSplit) Copy() *TrafficSplit {
+	val := *s
+	if s.Apis != nil {
+		apis := make([]*WeightedApi, len(s.Apis))
+		for i, v := range s.Apis {
+			api := *v
+			apis[i] = &api
+		}
+		val.Apis = apis
+	}
+	return &val
+}
+
+func (d *ProtoDescriptor) Copy() *ProtoDescriptor {
+	val := *d
+	if d.Data != nil {
+		data := make([]byte, len(d.Data))
+		copy(data, d.Data)
+		val.Data = data
+	}
+	return &val
+}
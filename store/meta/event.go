@@ -35,4 +35,7 @@ type EventListener interface {
 	RecvServiceConfig(op Operation, service string, data *ServiceConfig)
 	RecvApi(op Operation, service string, data *Api)
 	RecvServer(op Operation, service string, data *Server)
+	RecvIPAcl(op Operation, data *IPAcl)
+	RecvProtoDescriptor(op Operation, data *ProtoDescriptor)
+	RecvCachePurge(data *CachePurge)
 }
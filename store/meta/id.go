@@ -18,6 +18,10 @@ func (a *Auth) InitId() {
 
 func (r *Route) InitId() {
 	buf := &bytes.Buffer{}
+	if len(r.HostId) > 0 {
+		buf.WriteString(r.HostId)
+		buf.WriteString("@")
+	}
 	if len(r.Method) <= 0 {
 		buf.WriteString("*")
 	} else {
@@ -67,3 +71,15 @@ func (c *ServiceConfig) InitId() {
 func (g *Gateway) InitId() {
 	g.Id = md5x.SumString(strings.Strings(g.Addrs).Sort().Join(",")).String16()
 }
+
+func (a *IPAcl) InitId() {
+	a.Id = md5x.Sum([]byte(uuid.NewRandom())).String16()
+}
+
+func (d *ProtoDescriptor) InitId() {
+	d.Id = md5x.SumString(d.Name).String16()
+}
+
+func (p *CachePurge) InitId() {
+	p.Id = md5x.Sum([]byte(uuid.NewRandom())).String16()
+}
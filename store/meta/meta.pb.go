@@ -79,7 +79,7 @@ func (x ValueSource) String() string {
 	return proto.EnumName(ValueSource_name, int32(x))
 }
 func (ValueSource) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{0}
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{0}
 }
 
 type MatcherKind int32
@@ -117,7 +117,33 @@ func (x MatcherKind) String() string {
 	return proto.EnumName(MatcherKind_name, int32(x))
 }
 func (MatcherKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{1}
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{1}
+}
+
+type VersionSource int32
+
+const (
+	VersionSource_VersionPath   VersionSource = 0
+	VersionSource_VersionAccept VersionSource = 1
+	VersionSource_VersionHeader VersionSource = 2
+)
+
+var VersionSource_name = map[int32]string{
+	0: "VersionPath",
+	1: "VersionAccept",
+	2: "VersionHeader",
+}
+var VersionSource_value = map[string]int32{
+	"VersionPath":   0,
+	"VersionAccept": 1,
+	"VersionHeader": 2,
+}
+
+func (x VersionSource) String() string {
+	return proto.EnumName(VersionSource_name, int32(x))
+}
+func (VersionSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{2}
 }
 
 type Status int32
@@ -140,7 +166,7 @@ func (x Status) String() string {
 	return proto.EnumName(Status_name, int32(x))
 }
 func (Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{2}
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{3}
 }
 
 type LoadBalance int32
@@ -163,7 +189,33 @@ func (x LoadBalance) String() string {
 	return proto.EnumName(LoadBalance_name, int32(x))
 }
 func (LoadBalance) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{3}
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{4}
+}
+
+type ServerProtocol int32
+
+const (
+	ServerProtocol_HTTP1 ServerProtocol = 0
+	ServerProtocol_H2C   ServerProtocol = 1
+	ServerProtocol_H2    ServerProtocol = 2
+)
+
+var ServerProtocol_name = map[int32]string{
+	0: "HTTP1",
+	1: "H2C",
+	2: "H2",
+}
+var ServerProtocol_value = map[string]int32{
+	"HTTP1": 0,
+	"H2C":   1,
+	"H2":    2,
+}
+
+func (x ServerProtocol) String() string {
+	return proto.EnumName(ServerProtocol_name, int32(x))
+}
+func (ServerProtocol) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{5}
 }
 
 type HostKind int32
@@ -186,7 +238,7 @@ func (x HostKind) String() string {
 	return proto.EnumName(HostKind_name, int32(x))
 }
 func (HostKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{4}
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{6}
 }
 
 type AuthKind int32
@@ -209,7 +261,56 @@ func (x AuthKind) String() string {
 	return proto.EnumName(AuthKind_name, int32(x))
 }
 func (AuthKind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{5}
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{7}
+}
+
+type IPAclKind int32
+
+const (
+	IPAclKind_IPAllow IPAclKind = 0
+	IPAclKind_IPDeny  IPAclKind = 1
+)
+
+var IPAclKind_name = map[int32]string{
+	0: "IPAllow",
+	1: "IPDeny",
+}
+var IPAclKind_value = map[string]int32{
+	"IPAllow": 0,
+	"IPDeny":  1,
+}
+
+func (x IPAclKind) String() string {
+	return proto.EnumName(IPAclKind_name, int32(x))
+}
+func (IPAclKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{8}
+}
+
+type ErrorFormat int32
+
+const (
+	ErrorFormat_Text ErrorFormat = 0
+	ErrorFormat_JSON ErrorFormat = 1
+	ErrorFormat_HTML ErrorFormat = 2
+)
+
+var ErrorFormat_name = map[int32]string{
+	0: "Text",
+	1: "JSON",
+	2: "HTML",
+}
+var ErrorFormat_value = map[string]int32{
+	"Text": 0,
+	"JSON": 1,
+	"HTML": 2,
+}
+
+func (x ErrorFormat) String() string {
+	return proto.EnumName(ErrorFormat_name, int32(x))
+}
+func (ErrorFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{9}
 }
 
 type ValueItem struct {
@@ -224,7 +325,7 @@ func (m *ValueItem) Reset()         { *m = ValueItem{} }
 func (m *ValueItem) String() string { return proto.CompactTextString(m) }
 func (*ValueItem) ProtoMessage()    {}
 func (*ValueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{0}
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{0}
 }
 func (m *ValueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +381,7 @@ func (m *Matcher) Reset()         { *m = Matcher{} }
 func (m *Matcher) String() string { return proto.CompactTextString(m) }
 func (*Matcher) ProtoMessage()    {}
 func (*Matcher) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{1}
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{1}
 }
 func (m *Matcher) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,7 +443,7 @@ func (m *ApiCondition) Reset()         { *m = ApiCondition{} }
 func (m *ApiCondition) String() string { return proto.CompactTextString(m) }
 func (*ApiCondition) ProtoMessage()    {}
 func (*ApiCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{2}
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{2}
 }
 func (m *ApiCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,33 +486,26 @@ func (m *ApiCondition) GetApiId() string {
 	return ""
 }
 
-type Route struct {
-	Id                   string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status               Status                `protobuf:"varint,2,opt,name=status,proto3,enum=meta.Status" json:"status,omitempty"`
-	Path                 string                `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	Method               string                `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
-	Service              string                `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
-	ApiId                string                `protobuf:"bytes,6,opt,name=apiId,proto3" json:"apiId,omitempty"`
-	Context              map[string]*ValueItem `protobuf:"bytes,7,rep,name=context" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	ApiConds             []*ApiCondition       `protobuf:"bytes,8,rep,name=apiConds" json:"apiConds,omitempty"`
-	Files                string                `protobuf:"bytes,9,opt,name=files,proto3" json:"files,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type WeightedApi struct {
+	ApiId                string   `protobuf:"bytes,1,opt,name=apiId,proto3" json:"apiId,omitempty"`
+	Weight               int32    `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Route) Reset()         { *m = Route{} }
-func (m *Route) String() string { return proto.CompactTextString(m) }
-func (*Route) ProtoMessage()    {}
-func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{3}
+func (m *WeightedApi) Reset()         { *m = WeightedApi{} }
+func (m *WeightedApi) String() string { return proto.CompactTextString(m) }
+func (*WeightedApi) ProtoMessage()    {}
+func (*WeightedApi) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{3}
 }
-func (m *Route) XXX_Unmarshal(b []byte) error {
+func (m *WeightedApi) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WeightedApi) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WeightedApi.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -421,102 +515,124 @@ func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Route) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Route.Merge(dst, src)
+func (dst *WeightedApi) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedApi.Merge(dst, src)
 }
-func (m *Route) XXX_Size() int {
+func (m *WeightedApi) XXX_Size() int {
 	return m.Size()
 }
-func (m *Route) XXX_DiscardUnknown() {
-	xxx_messageInfo_Route.DiscardUnknown(m)
+func (m *WeightedApi) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedApi.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Route proto.InternalMessageInfo
+var xxx_messageInfo_WeightedApi proto.InternalMessageInfo
 
-func (m *Route) GetId() string {
+func (m *WeightedApi) GetApiId() string {
 	if m != nil {
-		return m.Id
+		return m.ApiId
 	}
 	return ""
 }
 
-func (m *Route) GetStatus() Status {
+func (m *WeightedApi) GetWeight() int32 {
 	if m != nil {
-		return m.Status
+		return m.Weight
 	}
-	return Status_Open
+	return 0
 }
 
-func (m *Route) GetPath() string {
-	if m != nil {
-		return m.Path
-	}
-	return ""
+type Mirror struct {
+	Service              string   `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	ServerId             string   `protobuf:"bytes,2,opt,name=serverId,proto3" json:"serverId,omitempty"`
+	Percent              int32    `protobuf:"varint,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	Timeout              int64    `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Route) GetMethod() string {
-	if m != nil {
-		return m.Method
+func (m *Mirror) Reset()         { *m = Mirror{} }
+func (m *Mirror) String() string { return proto.CompactTextString(m) }
+func (*Mirror) ProtoMessage()    {}
+func (*Mirror) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{4}
+}
+func (m *Mirror) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Mirror) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Mirror.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (dst *Mirror) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Mirror.Merge(dst, src)
+}
+func (m *Mirror) XXX_Size() int {
+	return m.Size()
+}
+func (m *Mirror) XXX_DiscardUnknown() {
+	xxx_messageInfo_Mirror.DiscardUnknown(m)
 }
 
-func (m *Route) GetService() string {
+var xxx_messageInfo_Mirror proto.InternalMessageInfo
+
+func (m *Mirror) GetService() string {
 	if m != nil {
 		return m.Service
 	}
 	return ""
 }
 
-func (m *Route) GetApiId() string {
+func (m *Mirror) GetServerId() string {
 	if m != nil {
-		return m.ApiId
+		return m.ServerId
 	}
 	return ""
 }
 
-func (m *Route) GetContext() map[string]*ValueItem {
-	if m != nil {
-		return m.Context
-	}
-	return nil
-}
-
-func (m *Route) GetApiConds() []*ApiCondition {
+func (m *Mirror) GetPercent() int32 {
 	if m != nil {
-		return m.ApiConds
+		return m.Percent
 	}
-	return nil
+	return 0
 }
 
-func (m *Route) GetFiles() string {
+func (m *Mirror) GetTimeout() int64 {
 	if m != nil {
-		return m.Files
+		return m.Timeout
 	}
-	return ""
+	return 0
 }
 
-type Validator struct {
-	Matcher              *Matcher `protobuf:"bytes,1,opt,name=matcher" json:"matcher,omitempty"`
-	ErrorMsg             string   `protobuf:"bytes,2,opt,name=errorMsg,proto3" json:"errorMsg,omitempty"`
-	Status               int32    `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ApiVersioning struct {
+	Source               VersionSource `protobuf:"varint,1,opt,name=source,proto3,enum=meta.VersionSource" json:"source,omitempty"`
+	Name                 string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DefaultVersion       string        `protobuf:"bytes,3,opt,name=defaultVersion,proto3" json:"defaultVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *Validator) Reset()         { *m = Validator{} }
-func (m *Validator) String() string { return proto.CompactTextString(m) }
-func (*Validator) ProtoMessage()    {}
-func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{4}
+func (m *ApiVersioning) Reset()         { *m = ApiVersioning{} }
+func (m *ApiVersioning) String() string { return proto.CompactTextString(m) }
+func (*ApiVersioning) ProtoMessage()    {}
+func (*ApiVersioning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{5}
 }
-func (m *Validator) XXX_Unmarshal(b []byte) error {
+func (m *ApiVersioning) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApiVersioning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Validator.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApiVersioning.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -526,59 +642,60 @@ func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Validator) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Validator.Merge(dst, src)
+func (dst *ApiVersioning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiVersioning.Merge(dst, src)
 }
-func (m *Validator) XXX_Size() int {
+func (m *ApiVersioning) XXX_Size() int {
 	return m.Size()
 }
-func (m *Validator) XXX_DiscardUnknown() {
-	xxx_messageInfo_Validator.DiscardUnknown(m)
+func (m *ApiVersioning) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiVersioning.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Validator proto.InternalMessageInfo
+var xxx_messageInfo_ApiVersioning proto.InternalMessageInfo
 
-func (m *Validator) GetMatcher() *Matcher {
+func (m *ApiVersioning) GetSource() VersionSource {
 	if m != nil {
-		return m.Matcher
+		return m.Source
 	}
-	return nil
+	return VersionSource_VersionPath
 }
 
-func (m *Validator) GetErrorMsg() string {
+func (m *ApiVersioning) GetName() string {
 	if m != nil {
-		return m.ErrorMsg
+		return m.Name
 	}
 	return ""
 }
 
-func (m *Validator) GetStatus() int32 {
+func (m *ApiVersioning) GetDefaultVersion() string {
 	if m != nil {
-		return m.Status
+		return m.DefaultVersion
 	}
-	return 0
+	return ""
 }
 
-type HeaderItem struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+type ApiDeprecation struct {
+	Date                 string   `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Sunset               string   `protobuf:"bytes,2,opt,name=sunset,proto3" json:"sunset,omitempty"`
+	Link                 string   `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HeaderItem) Reset()         { *m = HeaderItem{} }
-func (m *HeaderItem) String() string { return proto.CompactTextString(m) }
-func (*HeaderItem) ProtoMessage()    {}
-func (*HeaderItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{5}
+func (m *ApiDeprecation) Reset()         { *m = ApiDeprecation{} }
+func (m *ApiDeprecation) String() string { return proto.CompactTextString(m) }
+func (*ApiDeprecation) ProtoMessage()    {}
+func (*ApiDeprecation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{6}
 }
-func (m *HeaderItem) XXX_Unmarshal(b []byte) error {
+func (m *ApiDeprecation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HeaderItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApiDeprecation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HeaderItem.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApiDeprecation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -588,52 +705,60 @@ func (m *HeaderItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *HeaderItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HeaderItem.Merge(dst, src)
+func (dst *ApiDeprecation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiDeprecation.Merge(dst, src)
 }
-func (m *HeaderItem) XXX_Size() int {
+func (m *ApiDeprecation) XXX_Size() int {
 	return m.Size()
 }
-func (m *HeaderItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_HeaderItem.DiscardUnknown(m)
+func (m *ApiDeprecation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiDeprecation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HeaderItem proto.InternalMessageInfo
+var xxx_messageInfo_ApiDeprecation proto.InternalMessageInfo
 
-func (m *HeaderItem) GetName() string {
+func (m *ApiDeprecation) GetDate() string {
 	if m != nil {
-		return m.Name
+		return m.Date
 	}
 	return ""
 }
 
-func (m *HeaderItem) GetKey() string {
+func (m *ApiDeprecation) GetSunset() string {
 	if m != nil {
-		return m.Key
+		return m.Sunset
 	}
 	return ""
 }
 
-type ApiHeaders struct {
-	ToBackend            []*HeaderItem `protobuf:"bytes,1,rep,name=toBackend" json:"toBackend,omitempty"`
-	ToClient             []*HeaderItem `protobuf:"bytes,2,rep,name=toClient" json:"toClient,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *ApiDeprecation) GetLink() string {
+	if m != nil {
+		return m.Link
+	}
+	return ""
 }
 
-func (m *ApiHeaders) Reset()         { *m = ApiHeaders{} }
-func (m *ApiHeaders) String() string { return proto.CompactTextString(m) }
-func (*ApiHeaders) ProtoMessage()    {}
-func (*ApiHeaders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{6}
+type TrafficSplit struct {
+	Apis                 []*WeightedApi `protobuf:"bytes,1,rep,name=apis" json:"apis,omitempty"`
+	Cookie               string         `protobuf:"bytes,2,opt,name=cookie,proto3" json:"cookie,omitempty"`
+	Key                  string         `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
-func (m *ApiHeaders) XXX_Unmarshal(b []byte) error {
+
+func (m *TrafficSplit) Reset()         { *m = TrafficSplit{} }
+func (m *TrafficSplit) String() string { return proto.CompactTextString(m) }
+func (*TrafficSplit) ProtoMessage()    {}
+func (*TrafficSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{7}
+}
+func (m *TrafficSplit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApiHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TrafficSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApiHeaders.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TrafficSplit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -643,53 +768,70 @@ func (m *ApiHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *ApiHeaders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApiHeaders.Merge(dst, src)
+func (dst *TrafficSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficSplit.Merge(dst, src)
 }
-func (m *ApiHeaders) XXX_Size() int {
+func (m *TrafficSplit) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApiHeaders) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApiHeaders.DiscardUnknown(m)
+func (m *TrafficSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficSplit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApiHeaders proto.InternalMessageInfo
+var xxx_messageInfo_TrafficSplit proto.InternalMessageInfo
 
-func (m *ApiHeaders) GetToBackend() []*HeaderItem {
+func (m *TrafficSplit) GetApis() []*WeightedApi {
 	if m != nil {
-		return m.ToBackend
+		return m.Apis
 	}
 	return nil
 }
 
-func (m *ApiHeaders) GetToClient() []*HeaderItem {
+func (m *TrafficSplit) GetCookie() string {
 	if m != nil {
-		return m.ToClient
+		return m.Cookie
 	}
-	return nil
+	return ""
 }
 
-type CookieItem struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Expire               int64    `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *TrafficSplit) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *CookieItem) Reset()         { *m = CookieItem{} }
-func (m *CookieItem) String() string { return proto.CompactTextString(m) }
-func (*CookieItem) ProtoMessage()    {}
-func (*CookieItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{7}
+type Route struct {
+	Id                   string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status               Status                `protobuf:"varint,2,opt,name=status,proto3,enum=meta.Status" json:"status,omitempty"`
+	Path                 string                `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Method               string                `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Service              string                `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
+	ApiId                string                `protobuf:"bytes,6,opt,name=apiId,proto3" json:"apiId,omitempty"`
+	Context              map[string]*ValueItem `protobuf:"bytes,7,rep,name=context" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	ApiConds             []*ApiCondition       `protobuf:"bytes,8,rep,name=apiConds" json:"apiConds,omitempty"`
+	Files                string                `protobuf:"bytes,9,opt,name=files,proto3" json:"files,omitempty"`
+	HostId               string                `protobuf:"bytes,10,opt,name=hostId,proto3" json:"hostId,omitempty"`
+	Split                *TrafficSplit         `protobuf:"bytes,11,opt,name=split" json:"split,omitempty"`
+	Mirror               *Mirror               `protobuf:"bytes,12,opt,name=mirror" json:"mirror,omitempty"`
+	Versioning           *ApiVersioning        `protobuf:"bytes,13,opt,name=versioning" json:"versioning,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
-func (m *CookieItem) XXX_Unmarshal(b []byte) error {
+
+func (m *Route) Reset()         { *m = Route{} }
+func (m *Route) String() string { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()    {}
+func (*Route) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{8}
+}
+func (m *Route) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CookieItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CookieItem.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Route.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -699,249 +841,192 @@ func (m *CookieItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *CookieItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CookieItem.Merge(dst, src)
+func (dst *Route) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Route.Merge(dst, src)
 }
-func (m *CookieItem) XXX_Size() int {
+func (m *Route) XXX_Size() int {
 	return m.Size()
 }
-func (m *CookieItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_CookieItem.DiscardUnknown(m)
+func (m *Route) XXX_DiscardUnknown() {
+	xxx_messageInfo_Route.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CookieItem proto.InternalMessageInfo
+var xxx_messageInfo_Route proto.InternalMessageInfo
 
-func (m *CookieItem) GetName() string {
+func (m *Route) GetId() string {
 	if m != nil {
-		return m.Name
+		return m.Id
 	}
 	return ""
 }
 
-func (m *CookieItem) GetKey() string {
+func (m *Route) GetStatus() Status {
 	if m != nil {
-		return m.Key
+		return m.Status
 	}
-	return ""
+	return Status_Open
 }
 
-func (m *CookieItem) GetExpire() int64 {
+func (m *Route) GetPath() string {
 	if m != nil {
-		return m.Expire
-	}
-	return 0
-}
-
-type ApiCookies struct {
-	ToBackend            []*CookieItem `protobuf:"bytes,1,rep,name=toBackend" json:"toBackend,omitempty"`
-	ToClient             []*CookieItem `protobuf:"bytes,2,rep,name=toClient" json:"toClient,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *ApiCookies) Reset()         { *m = ApiCookies{} }
-func (m *ApiCookies) String() string { return proto.CompactTextString(m) }
-func (*ApiCookies) ProtoMessage()    {}
-func (*ApiCookies) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{8}
-}
-func (m *ApiCookies) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ApiCookies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ApiCookies.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+		return m.Path
 	}
+	return ""
 }
-func (dst *ApiCookies) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApiCookies.Merge(dst, src)
-}
-func (m *ApiCookies) XXX_Size() int {
-	return m.Size()
-}
-func (m *ApiCookies) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApiCookies.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ApiCookies proto.InternalMessageInfo
 
-func (m *ApiCookies) GetToBackend() []*CookieItem {
+func (m *Route) GetMethod() string {
 	if m != nil {
-		return m.ToBackend
+		return m.Method
 	}
-	return nil
+	return ""
 }
 
-func (m *ApiCookies) GetToClient() []*CookieItem {
+func (m *Route) GetService() string {
 	if m != nil {
-		return m.ToClient
-	}
-	return nil
-}
-
-type Api struct {
-	Id                   string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status               Status                `protobuf:"varint,2,opt,name=status,proto3,enum=meta.Status" json:"status,omitempty"`
-	Context              map[string]*ValueItem `protobuf:"bytes,3,rep,name=context" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	AuthId               string                `protobuf:"bytes,4,opt,name=authId,proto3" json:"authId,omitempty"`
-	Headers              *ApiHeaders           `protobuf:"bytes,5,opt,name=headers" json:"headers,omitempty"`
-	Cookies              *ApiCookies           `protobuf:"bytes,6,opt,name=cookies" json:"cookies,omitempty"`
-	Validators           []*Validator          `protobuf:"bytes,7,rep,name=validators" json:"validators,omitempty"`
-	Path                 string                `protobuf:"bytes,8,opt,name=path,proto3" json:"path,omitempty"`
-	Method               string                `protobuf:"bytes,9,opt,name=method,proto3" json:"method,omitempty"`
-	Version              string                `protobuf:"bytes,10,opt,name=version,proto3" json:"version,omitempty"`
-	Lambda               string                `protobuf:"bytes,11,opt,name=lambda,proto3" json:"lambda,omitempty"`
-	ServerId             string                `protobuf:"bytes,12,opt,name=serverId,proto3" json:"serverId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
-}
-
-func (m *Api) Reset()         { *m = Api{} }
-func (m *Api) String() string { return proto.CompactTextString(m) }
-func (*Api) ProtoMessage()    {}
-func (*Api) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{9}
-}
-func (m *Api) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Api) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Api.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+		return m.Service
 	}
+	return ""
 }
-func (dst *Api) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Api.Merge(dst, src)
-}
-func (m *Api) XXX_Size() int {
-	return m.Size()
-}
-func (m *Api) XXX_DiscardUnknown() {
-	xxx_messageInfo_Api.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Api proto.InternalMessageInfo
 
-func (m *Api) GetId() string {
+func (m *Route) GetApiId() string {
 	if m != nil {
-		return m.Id
+		return m.ApiId
 	}
 	return ""
 }
 
-func (m *Api) GetStatus() Status {
+func (m *Route) GetContext() map[string]*ValueItem {
 	if m != nil {
-		return m.Status
+		return m.Context
 	}
-	return Status_Open
+	return nil
 }
 
-func (m *Api) GetContext() map[string]*ValueItem {
+func (m *Route) GetApiConds() []*ApiCondition {
 	if m != nil {
-		return m.Context
+		return m.ApiConds
 	}
 	return nil
 }
 
-func (m *Api) GetAuthId() string {
+func (m *Route) GetFiles() string {
 	if m != nil {
-		return m.AuthId
+		return m.Files
 	}
 	return ""
 }
 
-func (m *Api) GetHeaders() *ApiHeaders {
+func (m *Route) GetHostId() string {
 	if m != nil {
-		return m.Headers
+		return m.HostId
 	}
-	return nil
+	return ""
 }
 
-func (m *Api) GetCookies() *ApiCookies {
+func (m *Route) GetSplit() *TrafficSplit {
 	if m != nil {
-		return m.Cookies
+		return m.Split
 	}
 	return nil
 }
 
-func (m *Api) GetValidators() []*Validator {
+func (m *Route) GetMirror() *Mirror {
 	if m != nil {
-		return m.Validators
+		return m.Mirror
 	}
 	return nil
 }
 
-func (m *Api) GetPath() string {
+func (m *Route) GetVersioning() *ApiVersioning {
 	if m != nil {
-		return m.Path
+		return m.Versioning
 	}
-	return ""
+	return nil
 }
 
-func (m *Api) GetMethod() string {
-	if m != nil {
-		return m.Method
+type Validator struct {
+	Matcher              *Matcher `protobuf:"bytes,1,opt,name=matcher" json:"matcher,omitempty"`
+	ErrorMsg             string   `protobuf:"bytes,2,opt,name=errorMsg,proto3" json:"errorMsg,omitempty"`
+	Status               int32    `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Validator) Reset()         { *m = Validator{} }
+func (m *Validator) String() string { return proto.CompactTextString(m) }
+func (*Validator) ProtoMessage()    {}
+func (*Validator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{9}
+}
+func (m *Validator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Validator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (dst *Validator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Validator.Merge(dst, src)
+}
+func (m *Validator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Validator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Validator.DiscardUnknown(m)
 }
 
-func (m *Api) GetVersion() string {
+var xxx_messageInfo_Validator proto.InternalMessageInfo
+
+func (m *Validator) GetMatcher() *Matcher {
 	if m != nil {
-		return m.Version
+		return m.Matcher
 	}
-	return ""
+	return nil
 }
 
-func (m *Api) GetLambda() string {
+func (m *Validator) GetErrorMsg() string {
 	if m != nil {
-		return m.Lambda
+		return m.ErrorMsg
 	}
 	return ""
 }
 
-func (m *Api) GetServerId() string {
+func (m *Validator) GetStatus() int32 {
 	if m != nil {
-		return m.ServerId
+		return m.Status
 	}
-	return ""
+	return 0
 }
 
-type Service struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+type HeaderItem struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Service) Reset()         { *m = Service{} }
-func (m *Service) String() string { return proto.CompactTextString(m) }
-func (*Service) ProtoMessage()    {}
-func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{10}
+func (m *HeaderItem) Reset()         { *m = HeaderItem{} }
+func (m *HeaderItem) String() string { return proto.CompactTextString(m) }
+func (*HeaderItem) ProtoMessage()    {}
+func (*HeaderItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{10}
 }
-func (m *Service) XXX_Unmarshal(b []byte) error {
+func (m *HeaderItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Service) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HeaderItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Service.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HeaderItem.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -951,55 +1036,52 @@ func (m *Service) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Service) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Service.Merge(dst, src)
+func (dst *HeaderItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderItem.Merge(dst, src)
 }
-func (m *Service) XXX_Size() int {
+func (m *HeaderItem) XXX_Size() int {
 	return m.Size()
 }
-func (m *Service) XXX_DiscardUnknown() {
-	xxx_messageInfo_Service.DiscardUnknown(m)
+func (m *HeaderItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Service proto.InternalMessageInfo
+var xxx_messageInfo_HeaderItem proto.InternalMessageInfo
 
-func (m *Service) GetId() string {
+func (m *HeaderItem) GetName() string {
 	if m != nil {
-		return m.Id
+		return m.Name
 	}
 	return ""
 }
 
-func (m *Service) GetName() string {
+func (m *HeaderItem) GetKey() string {
 	if m != nil {
-		return m.Name
+		return m.Key
 	}
 	return ""
 }
 
-type ServiceConfig struct {
-	Id                   string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status               Status                `protobuf:"varint,2,opt,name=status,proto3,enum=meta.Status" json:"status,omitempty"`
-	LoadBlance           LoadBalance           `protobuf:"varint,3,opt,name=loadBlance,proto3,enum=meta.LoadBalance" json:"loadBlance,omitempty"`
-	Context              map[string]*ValueItem `protobuf:"bytes,4,rep,name=context" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	AuthId               string                `protobuf:"bytes,5,opt,name=authId,proto3" json:"authId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type ApiHeaders struct {
+	ToBackend            []*HeaderItem `protobuf:"bytes,1,rep,name=toBackend" json:"toBackend,omitempty"`
+	ToClient             []*HeaderItem `protobuf:"bytes,2,rep,name=toClient" json:"toClient,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ServiceConfig) Reset()         { *m = ServiceConfig{} }
-func (m *ServiceConfig) String() string { return proto.CompactTextString(m) }
-func (*ServiceConfig) ProtoMessage()    {}
-func (*ServiceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{11}
+func (m *ApiHeaders) Reset()         { *m = ApiHeaders{} }
+func (m *ApiHeaders) String() string { return proto.CompactTextString(m) }
+func (*ApiHeaders) ProtoMessage()    {}
+func (*ApiHeaders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{11}
 }
-func (m *ServiceConfig) XXX_Unmarshal(b []byte) error {
+func (m *ApiHeaders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ServiceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApiHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ServiceConfig.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApiHeaders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1009,75 +1091,53 @@ func (m *ServiceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (dst *ServiceConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ServiceConfig.Merge(dst, src)
+func (dst *ApiHeaders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiHeaders.Merge(dst, src)
 }
-func (m *ServiceConfig) XXX_Size() int {
+func (m *ApiHeaders) XXX_Size() int {
 	return m.Size()
 }
-func (m *ServiceConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_ServiceConfig.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ServiceConfig proto.InternalMessageInfo
-
-func (m *ServiceConfig) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
-
-func (m *ServiceConfig) GetStatus() Status {
-	if m != nil {
-		return m.Status
-	}
-	return Status_Open
+func (m *ApiHeaders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiHeaders.DiscardUnknown(m)
 }
 
-func (m *ServiceConfig) GetLoadBlance() LoadBalance {
-	if m != nil {
-		return m.LoadBlance
-	}
-	return LoadBalance_RoundRobin
-}
+var xxx_messageInfo_ApiHeaders proto.InternalMessageInfo
 
-func (m *ServiceConfig) GetContext() map[string]*ValueItem {
+func (m *ApiHeaders) GetToBackend() []*HeaderItem {
 	if m != nil {
-		return m.Context
+		return m.ToBackend
 	}
 	return nil
 }
 
-func (m *ServiceConfig) GetAuthId() string {
+func (m *ApiHeaders) GetToClient() []*HeaderItem {
 	if m != nil {
-		return m.AuthId
+		return m.ToClient
 	}
-	return ""
+	return nil
 }
 
-type HealthCheck struct {
-	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Body                 string   `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
-	Interval             int64    `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
-	Timeout              int64    `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+type CookieItem struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Expire               int64    `protobuf:"varint,3,opt,name=expire,proto3" json:"expire,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HealthCheck) Reset()         { *m = HealthCheck{} }
-func (m *HealthCheck) String() string { return proto.CompactTextString(m) }
-func (*HealthCheck) ProtoMessage()    {}
-func (*HealthCheck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{12}
+func (m *CookieItem) Reset()         { *m = CookieItem{} }
+func (m *CookieItem) String() string { return proto.CompactTextString(m) }
+func (*CookieItem) ProtoMessage()    {}
+func (*CookieItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{12}
 }
-func (m *HealthCheck) XXX_Unmarshal(b []byte) error {
+func (m *CookieItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CookieItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HealthCheck.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CookieItem.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1087,71 +1147,134 @@ func (m *HealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (dst *HealthCheck) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HealthCheck.Merge(dst, src)
+func (dst *CookieItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CookieItem.Merge(dst, src)
 }
-func (m *HealthCheck) XXX_Size() int {
+func (m *CookieItem) XXX_Size() int {
 	return m.Size()
 }
-func (m *HealthCheck) XXX_DiscardUnknown() {
-	xxx_messageInfo_HealthCheck.DiscardUnknown(m)
+func (m *CookieItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CookieItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HealthCheck proto.InternalMessageInfo
+var xxx_messageInfo_CookieItem proto.InternalMessageInfo
 
-func (m *HealthCheck) GetPath() string {
+func (m *CookieItem) GetName() string {
 	if m != nil {
-		return m.Path
+		return m.Name
 	}
 	return ""
 }
 
-func (m *HealthCheck) GetBody() string {
+func (m *CookieItem) GetKey() string {
 	if m != nil {
-		return m.Body
+		return m.Key
 	}
 	return ""
 }
 
-func (m *HealthCheck) GetInterval() int64 {
+func (m *CookieItem) GetExpire() int64 {
 	if m != nil {
-		return m.Interval
+		return m.Expire
 	}
 	return 0
 }
 
-func (m *HealthCheck) GetTimeout() int64 {
+type ApiCookies struct {
+	ToBackend            []*CookieItem `protobuf:"bytes,1,rep,name=toBackend" json:"toBackend,omitempty"`
+	ToClient             []*CookieItem `protobuf:"bytes,2,rep,name=toClient" json:"toClient,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ApiCookies) Reset()         { *m = ApiCookies{} }
+func (m *ApiCookies) String() string { return proto.CompactTextString(m) }
+func (*ApiCookies) ProtoMessage()    {}
+func (*ApiCookies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{13}
+}
+func (m *ApiCookies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApiCookies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApiCookies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApiCookies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiCookies.Merge(dst, src)
+}
+func (m *ApiCookies) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApiCookies) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiCookies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiCookies proto.InternalMessageInfo
+
+func (m *ApiCookies) GetToBackend() []*CookieItem {
 	if m != nil {
-		return m.Timeout
+		return m.ToBackend
 	}
-	return 0
+	return nil
 }
 
-type Server struct {
-	Id                   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status               Status       `protobuf:"varint,2,opt,name=status,proto3,enum=meta.Status" json:"status,omitempty"`
-	Name                 string       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Host                 string       `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
-	Addr                 string       `protobuf:"bytes,5,opt,name=addr,proto3" json:"addr,omitempty"`
-	HealthCheck          *HealthCheck `protobuf:"bytes,6,opt,name=healthCheck" json:"healthCheck,omitempty"`
-	MaxQPS               int64        `protobuf:"varint,7,opt,name=maxQPS,proto3" json:"maxQPS,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *ApiCookies) GetToClient() []*CookieItem {
+	if m != nil {
+		return m.ToClient
+	}
+	return nil
 }
 
-func (m *Server) Reset()         { *m = Server{} }
-func (m *Server) String() string { return proto.CompactTextString(m) }
-func (*Server) ProtoMessage()    {}
-func (*Server) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{13}
+type Api struct {
+	Id                   string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status               Status                `protobuf:"varint,2,opt,name=status,proto3,enum=meta.Status" json:"status,omitempty"`
+	Context              map[string]*ValueItem `protobuf:"bytes,3,rep,name=context" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	AuthId               string                `protobuf:"bytes,4,opt,name=authId,proto3" json:"authId,omitempty"`
+	Headers              *ApiHeaders           `protobuf:"bytes,5,opt,name=headers" json:"headers,omitempty"`
+	Cookies              *ApiCookies           `protobuf:"bytes,6,opt,name=cookies" json:"cookies,omitempty"`
+	Validators           []*Validator          `protobuf:"bytes,7,rep,name=validators" json:"validators,omitempty"`
+	Path                 string                `protobuf:"bytes,8,opt,name=path,proto3" json:"path,omitempty"`
+	Method               string                `protobuf:"bytes,9,opt,name=method,proto3" json:"method,omitempty"`
+	Version              string                `protobuf:"bytes,10,opt,name=version,proto3" json:"version,omitempty"`
+	Lambda               string                `protobuf:"bytes,11,opt,name=lambda,proto3" json:"lambda,omitempty"`
+	ServerId             string                `protobuf:"bytes,12,opt,name=serverId,proto3" json:"serverId,omitempty"`
+	ErrorPages           *ErrorPages           `protobuf:"bytes,13,opt,name=errorPages" json:"errorPages,omitempty"`
+	Cache                *CacheConfig          `protobuf:"bytes,14,opt,name=cache" json:"cache,omitempty"`
+	DisableCompression   bool                  `protobuf:"varint,15,opt,name=disableCompression,proto3" json:"disableCompression,omitempty"`
+	Cors                 *CorsPolicy           `protobuf:"bytes,16,opt,name=cors" json:"cors,omitempty"`
+	Stream               bool                  `protobuf:"varint,17,opt,name=stream,proto3" json:"stream,omitempty"`
+	MaxBodySize          int64                 `protobuf:"varint,18,opt,name=maxBodySize,proto3" json:"maxBodySize,omitempty"`
+	Grpc                 *GrpcTranscode        `protobuf:"bytes,19,opt,name=grpc" json:"grpc,omitempty"`
+	Timeout              int64                 `protobuf:"varint,20,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Mirror               *Mirror               `protobuf:"bytes,21,opt,name=mirror" json:"mirror,omitempty"`
+	Deprecation          *ApiDeprecation       `protobuf:"bytes,22,opt,name=deprecation" json:"deprecation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
-func (m *Server) XXX_Unmarshal(b []byte) error {
+
+func (m *Api) Reset()         { *m = Api{} }
+func (m *Api) String() string { return proto.CompactTextString(m) }
+func (*Api) ProtoMessage()    {}
+func (*Api) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{14}
+}
+func (m *Api) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Server) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Api) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Server.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Api.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1161,87 +1284,192 @@ func (m *Server) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Server) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Server.Merge(dst, src)
+func (dst *Api) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Api.Merge(dst, src)
 }
-func (m *Server) XXX_Size() int {
+func (m *Api) XXX_Size() int {
 	return m.Size()
 }
-func (m *Server) XXX_DiscardUnknown() {
-	xxx_messageInfo_Server.DiscardUnknown(m)
+func (m *Api) XXX_DiscardUnknown() {
+	xxx_messageInfo_Api.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Server proto.InternalMessageInfo
+var xxx_messageInfo_Api proto.InternalMessageInfo
 
-func (m *Server) GetId() string {
+func (m *Api) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *Server) GetStatus() Status {
+func (m *Api) GetStatus() Status {
 	if m != nil {
 		return m.Status
 	}
 	return Status_Open
 }
 
-func (m *Server) GetName() string {
+func (m *Api) GetContext() map[string]*ValueItem {
 	if m != nil {
-		return m.Name
+		return m.Context
 	}
-	return ""
+	return nil
 }
 
-func (m *Server) GetHost() string {
+func (m *Api) GetAuthId() string {
 	if m != nil {
-		return m.Host
+		return m.AuthId
 	}
 	return ""
 }
 
-func (m *Server) GetAddr() string {
+func (m *Api) GetHeaders() *ApiHeaders {
 	if m != nil {
-		return m.Addr
+		return m.Headers
 	}
-	return ""
+	return nil
 }
 
-func (m *Server) GetHealthCheck() *HealthCheck {
+func (m *Api) GetCookies() *ApiCookies {
 	if m != nil {
-		return m.HealthCheck
+		return m.Cookies
 	}
 	return nil
 }
 
-func (m *Server) GetMaxQPS() int64 {
+func (m *Api) GetValidators() []*Validator {
 	if m != nil {
-		return m.MaxQPS
+		return m.Validators
 	}
-	return 0
+	return nil
 }
 
-type Gateway struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Addrs                []string `protobuf:"bytes,2,rep,name=addrs" json:"addrs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Api) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
 }
 
-func (m *Gateway) Reset()         { *m = Gateway{} }
-func (m *Gateway) String() string { return proto.CompactTextString(m) }
-func (*Gateway) ProtoMessage()    {}
-func (*Gateway) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{14}
+func (m *Api) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
 }
-func (m *Gateway) XXX_Unmarshal(b []byte) error {
+
+func (m *Api) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Api) GetLambda() string {
+	if m != nil {
+		return m.Lambda
+	}
+	return ""
+}
+
+func (m *Api) GetServerId() string {
+	if m != nil {
+		return m.ServerId
+	}
+	return ""
+}
+
+func (m *Api) GetErrorPages() *ErrorPages {
+	if m != nil {
+		return m.ErrorPages
+	}
+	return nil
+}
+
+func (m *Api) GetCache() *CacheConfig {
+	if m != nil {
+		return m.Cache
+	}
+	return nil
+}
+
+func (m *Api) GetDisableCompression() bool {
+	if m != nil {
+		return m.DisableCompression
+	}
+	return false
+}
+
+func (m *Api) GetCors() *CorsPolicy {
+	if m != nil {
+		return m.Cors
+	}
+	return nil
+}
+
+func (m *Api) GetStream() bool {
+	if m != nil {
+		return m.Stream
+	}
+	return false
+}
+
+func (m *Api) GetMaxBodySize() int64 {
+	if m != nil {
+		return m.MaxBodySize
+	}
+	return 0
+}
+
+func (m *Api) GetGrpc() *GrpcTranscode {
+	if m != nil {
+		return m.Grpc
+	}
+	return nil
+}
+
+func (m *Api) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *Api) GetMirror() *Mirror {
+	if m != nil {
+		return m.Mirror
+	}
+	return nil
+}
+
+func (m *Api) GetDeprecation() *ApiDeprecation {
+	if m != nil {
+		return m.Deprecation
+	}
+	return nil
+}
+
+type Service struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Service) Reset()         { *m = Service{} }
+func (m *Service) String() string { return proto.CompactTextString(m) }
+func (*Service) ProtoMessage()    {}
+func (*Service) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{15}
+}
+func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Gateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Service) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Gateway.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Service.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1251,56 +1479,60 @@ func (m *Gateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Gateway) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Gateway.Merge(dst, src)
+func (dst *Service) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Service.Merge(dst, src)
 }
-func (m *Gateway) XXX_Size() int {
+func (m *Service) XXX_Size() int {
 	return m.Size()
 }
-func (m *Gateway) XXX_DiscardUnknown() {
-	xxx_messageInfo_Gateway.DiscardUnknown(m)
+func (m *Service) XXX_DiscardUnknown() {
+	xxx_messageInfo_Service.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Gateway proto.InternalMessageInfo
+var xxx_messageInfo_Service proto.InternalMessageInfo
 
-func (m *Gateway) GetId() string {
+func (m *Service) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *Gateway) GetAddrs() []string {
+func (m *Service) GetName() string {
 	if m != nil {
-		return m.Addrs
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type Host struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Kind                 HostKind `protobuf:"varint,2,opt,name=kind,proto3,enum=meta.HostKind" json:"kind,omitempty"`
-	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	Service              string   `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
-	ApiId                string   `protobuf:"bytes,5,opt,name=apiId,proto3" json:"apiId,omitempty"`
-	SvrId                string   `protobuf:"bytes,6,opt,name=svrId,proto3" json:"svrId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ServiceConfig struct {
+	Id                   string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status               Status                `protobuf:"varint,2,opt,name=status,proto3,enum=meta.Status" json:"status,omitempty"`
+	LoadBlance           LoadBalance           `protobuf:"varint,3,opt,name=loadBlance,proto3,enum=meta.LoadBalance" json:"loadBlance,omitempty"`
+	Context              map[string]*ValueItem `protobuf:"bytes,4,rep,name=context" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	AuthId               string                `protobuf:"bytes,5,opt,name=authId,proto3" json:"authId,omitempty"`
+	ProxyHeaders         *ProxyHeaders         `protobuf:"bytes,6,opt,name=proxyHeaders" json:"proxyHeaders,omitempty"`
+	ErrorPages           *ErrorPages           `protobuf:"bytes,7,opt,name=errorPages" json:"errorPages,omitempty"`
+	Cors                 *CorsPolicy           `protobuf:"bytes,8,opt,name=cors" json:"cors,omitempty"`
+	Client               *UpstreamClient       `protobuf:"bytes,9,opt,name=client" json:"client,omitempty"`
+	Timeout              int64                 `protobuf:"varint,10,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *Host) Reset()         { *m = Host{} }
-func (m *Host) String() string { return proto.CompactTextString(m) }
-func (*Host) ProtoMessage()    {}
-func (*Host) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{15}
+func (m *ServiceConfig) Reset()         { *m = ServiceConfig{} }
+func (m *ServiceConfig) String() string { return proto.CompactTextString(m) }
+func (*ServiceConfig) ProtoMessage()    {}
+func (*ServiceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{16}
 }
-func (m *Host) XXX_Unmarshal(b []byte) error {
+func (m *ServiceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Host) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ServiceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Host.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ServiceConfig.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1310,81 +1542,111 @@ func (m *Host) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Host) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Host.Merge(dst, src)
+func (dst *ServiceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceConfig.Merge(dst, src)
 }
-func (m *Host) XXX_Size() int {
+func (m *ServiceConfig) XXX_Size() int {
 	return m.Size()
 }
-func (m *Host) XXX_DiscardUnknown() {
-	xxx_messageInfo_Host.DiscardUnknown(m)
+func (m *ServiceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceConfig.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Host proto.InternalMessageInfo
+var xxx_messageInfo_ServiceConfig proto.InternalMessageInfo
 
-func (m *Host) GetId() string {
+func (m *ServiceConfig) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *Host) GetKind() HostKind {
+func (m *ServiceConfig) GetStatus() Status {
 	if m != nil {
-		return m.Kind
+		return m.Status
 	}
-	return HostKind_Allow
+	return Status_Open
 }
 
-func (m *Host) GetValue() string {
+func (m *ServiceConfig) GetLoadBlance() LoadBalance {
 	if m != nil {
-		return m.Value
+		return m.LoadBlance
 	}
-	return ""
+	return LoadBalance_RoundRobin
 }
 
-func (m *Host) GetService() string {
+func (m *ServiceConfig) GetContext() map[string]*ValueItem {
 	if m != nil {
-		return m.Service
+		return m.Context
 	}
-	return ""
+	return nil
 }
 
-func (m *Host) GetApiId() string {
+func (m *ServiceConfig) GetAuthId() string {
 	if m != nil {
-		return m.ApiId
+		return m.AuthId
 	}
 	return ""
 }
 
-func (m *Host) GetSvrId() string {
+func (m *ServiceConfig) GetProxyHeaders() *ProxyHeaders {
 	if m != nil {
-		return m.SvrId
+		return m.ProxyHeaders
 	}
-	return ""
+	return nil
 }
 
-type Auth struct {
-	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Kind                 AuthKind          `protobuf:"varint,2,opt,name=kind,proto3,enum=meta.AuthKind" json:"kind,omitempty"`
-	Config               map[string]string `protobuf:"bytes,3,rep,name=config" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *ServiceConfig) GetErrorPages() *ErrorPages {
+	if m != nil {
+		return m.ErrorPages
+	}
+	return nil
 }
 
-func (m *Auth) Reset()         { *m = Auth{} }
-func (m *Auth) String() string { return proto.CompactTextString(m) }
-func (*Auth) ProtoMessage()    {}
-func (*Auth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_a4a970b386fb62e0, []int{16}
+func (m *ServiceConfig) GetCors() *CorsPolicy {
+	if m != nil {
+		return m.Cors
+	}
+	return nil
 }
-func (m *Auth) XXX_Unmarshal(b []byte) error {
+
+func (m *ServiceConfig) GetClient() *UpstreamClient {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+func (m *ServiceConfig) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+type ProxyHeaders struct {
+	DisableXForwarded    bool     `protobuf:"varint,1,opt,name=disableXForwarded,proto3" json:"disableXForwarded,omitempty"`
+	DisableXRealIp       bool     `protobuf:"varint,2,opt,name=disableXRealIp,proto3" json:"disableXRealIp,omitempty"`
+	DisableVia           bool     `protobuf:"varint,3,opt,name=disableVia,proto3" json:"disableVia,omitempty"`
+	Forwarded            bool     `protobuf:"varint,4,opt,name=forwarded,proto3" json:"forwarded,omitempty"`
+	Via                  string   `protobuf:"bytes,5,opt,name=via,proto3" json:"via,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProxyHeaders) Reset()         { *m = ProxyHeaders{} }
+func (m *ProxyHeaders) String() string { return proto.CompactTextString(m) }
+func (*ProxyHeaders) ProtoMessage()    {}
+func (*ProxyHeaders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{17}
+}
+func (m *ProxyHeaders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Auth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProxyHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Auth.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProxyHeaders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1394,911 +1656,1169 @@ func (m *Auth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (dst *Auth) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Auth.Merge(dst, src)
+func (dst *ProxyHeaders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyHeaders.Merge(dst, src)
 }
-func (m *Auth) XXX_Size() int {
+func (m *ProxyHeaders) XXX_Size() int {
 	return m.Size()
 }
-func (m *Auth) XXX_DiscardUnknown() {
-	xxx_messageInfo_Auth.DiscardUnknown(m)
+func (m *ProxyHeaders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyHeaders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Auth proto.InternalMessageInfo
+var xxx_messageInfo_ProxyHeaders proto.InternalMessageInfo
 
-func (m *Auth) GetId() string {
+func (m *ProxyHeaders) GetDisableXForwarded() bool {
 	if m != nil {
-		return m.Id
+		return m.DisableXForwarded
 	}
-	return ""
+	return false
 }
 
-func (m *Auth) GetKind() AuthKind {
+func (m *ProxyHeaders) GetDisableXRealIp() bool {
 	if m != nil {
-		return m.Kind
+		return m.DisableXRealIp
 	}
-	return AuthKind_HttpBasic
+	return false
 }
 
-func (m *Auth) GetConfig() map[string]string {
+func (m *ProxyHeaders) GetDisableVia() bool {
 	if m != nil {
-		return m.Config
+		return m.DisableVia
 	}
-	return nil
+	return false
 }
 
-func init() {
-	proto.RegisterType((*ValueItem)(nil), "meta.ValueItem")
-	proto.RegisterType((*Matcher)(nil), "meta.Matcher")
-	proto.RegisterType((*ApiCondition)(nil), "meta.ApiCondition")
-	proto.RegisterType((*Route)(nil), "meta.Route")
-	proto.RegisterMapType((map[string]*ValueItem)(nil), "meta.Route.ContextEntry")
-	proto.RegisterType((*Validator)(nil), "meta.Validator")
-	proto.RegisterType((*HeaderItem)(nil), "meta.HeaderItem")
-	proto.RegisterType((*ApiHeaders)(nil), "meta.ApiHeaders")
-	proto.RegisterType((*CookieItem)(nil), "meta.CookieItem")
-	proto.RegisterType((*ApiCookies)(nil), "meta.ApiCookies")
-	proto.RegisterType((*Api)(nil), "meta.Api")
-	proto.RegisterMapType((map[string]*ValueItem)(nil), "meta.Api.ContextEntry")
-	proto.RegisterType((*Service)(nil), "meta.Service")
-	proto.RegisterType((*ServiceConfig)(nil), "meta.ServiceConfig")
-	proto.RegisterMapType((map[string]*ValueItem)(nil), "meta.ServiceConfig.ContextEntry")
-	proto.RegisterType((*HealthCheck)(nil), "meta.HealthCheck")
-	proto.RegisterType((*Server)(nil), "meta.Server")
-	proto.RegisterType((*Gateway)(nil), "meta.Gateway")
-	proto.RegisterType((*Host)(nil), "meta.Host")
-	proto.RegisterType((*Auth)(nil), "meta.Auth")
-	proto.RegisterMapType((map[string]string)(nil), "meta.Auth.ConfigEntry")
-	proto.RegisterEnum("meta.ValueSource", ValueSource_name, ValueSource_value)
-	proto.RegisterEnum("meta.MatcherKind", MatcherKind_name, MatcherKind_value)
-	proto.RegisterEnum("meta.Status", Status_name, Status_value)
-	proto.RegisterEnum("meta.LoadBalance", LoadBalance_name, LoadBalance_value)
-	proto.RegisterEnum("meta.HostKind", HostKind_name, HostKind_value)
-	proto.RegisterEnum("meta.AuthKind", AuthKind_name, AuthKind_value)
-}
-func (m *ValueItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ProxyHeaders) GetForwarded() bool {
+	if m != nil {
+		return m.Forwarded
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ValueItem) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Source != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Source))
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ProxyHeaders) GetVia() string {
+	if m != nil {
+		return m.Via
 	}
-	return i, nil
+	return ""
 }
 
-func (m *Matcher) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type HealthCheck struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Body                 string   `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Interval             int64    `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	Timeout              int64    `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Matcher) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+func (m *HealthCheck) Reset()         { *m = HealthCheck{} }
+func (m *HealthCheck) String() string { return proto.CompactTextString(m) }
+func (*HealthCheck) ProtoMessage()    {}
+func (*HealthCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{18}
+}
+func (m *HealthCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HealthCheck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Kind != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Kind))
+}
+func (dst *HealthCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCheck.Merge(dst, src)
+}
+func (m *HealthCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthCheck proto.InternalMessageInfo
+
+func (m *HealthCheck) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+	return ""
+}
+
+func (m *HealthCheck) GetBody() string {
+	if m != nil {
+		return m.Body
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *HealthCheck) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ApiCondition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *HealthCheck) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ApiCondition) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Matcher != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Matcher.Size()))
-		n1, err := m.Matcher.MarshalTo(dAtA[i:])
+type Server struct {
+	Id                   string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status               Status          `protobuf:"varint,2,opt,name=status,proto3,enum=meta.Status" json:"status,omitempty"`
+	Name                 string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Host                 string          `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	Addr                 string          `protobuf:"bytes,5,opt,name=addr,proto3" json:"addr,omitempty"`
+	HealthCheck          *HealthCheck    `protobuf:"bytes,6,opt,name=healthCheck" json:"healthCheck,omitempty"`
+	MaxQPS               int64           `protobuf:"varint,7,opt,name=maxQPS,proto3" json:"maxQPS,omitempty"`
+	Protocol             ServerProtocol  `protobuf:"varint,8,opt,name=protocol,proto3,enum=meta.ServerProtocol" json:"protocol,omitempty"`
+	Client               *UpstreamClient `protobuf:"bytes,9,opt,name=client" json:"client,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Server) Reset()         { *m = Server{} }
+func (m *Server) String() string { return proto.CompactTextString(m) }
+func (*Server) ProtoMessage()    {}
+func (*Server) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{19}
+}
+func (m *Server) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Server) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Server.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
+		return b[:n], nil
 	}
-	if len(m.ApiId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.ApiId)))
-		i += copy(dAtA[i:], m.ApiId)
+}
+func (dst *Server) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Server.Merge(dst, src)
+}
+func (m *Server) XXX_Size() int {
+	return m.Size()
+}
+func (m *Server) XXX_DiscardUnknown() {
+	xxx_messageInfo_Server.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Server proto.InternalMessageInfo
+
+func (m *Server) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *Server) GetStatus() Status {
+	if m != nil {
+		return m.Status
 	}
-	return i, nil
+	return Status_Open
 }
 
-func (m *Route) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Server) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Route) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func (m *Server) GetHost() string {
+	if m != nil {
+		return m.Host
 	}
-	if m.Status != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Status))
+	return ""
+}
+
+func (m *Server) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	if len(m.Path) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Path)))
-		i += copy(dAtA[i:], m.Path)
+	return ""
+}
+
+func (m *Server) GetHealthCheck() *HealthCheck {
+	if m != nil {
+		return m.HealthCheck
 	}
-	if len(m.Method) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Method)))
-		i += copy(dAtA[i:], m.Method)
+	return nil
+}
+
+func (m *Server) GetMaxQPS() int64 {
+	if m != nil {
+		return m.MaxQPS
 	}
-	if len(m.Service) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Service)))
-		i += copy(dAtA[i:], m.Service)
-	}
-	if len(m.ApiId) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.ApiId)))
-		i += copy(dAtA[i:], m.ApiId)
+	return 0
+}
+
+func (m *Server) GetProtocol() ServerProtocol {
+	if m != nil {
+		return m.Protocol
 	}
-	if len(m.Context) > 0 {
-		for k, _ := range m.Context {
-			dAtA[i] = 0x3a
-			i++
-			v := m.Context[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovMeta(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovMeta(uint64(len(k))) + msgSize
-			i = encodeVarintMeta(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintMeta(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintMeta(dAtA, i, uint64(v.Size()))
-				n2, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n2
-			}
-		}
+	return ServerProtocol_HTTP1
+}
+
+func (m *Server) GetClient() *UpstreamClient {
+	if m != nil {
+		return m.Client
 	}
-	if len(m.ApiConds) > 0 {
-		for _, msg := range m.ApiConds {
-			dAtA[i] = 0x42
-			i++
-			i = encodeVarintMeta(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	return nil
+}
+
+type Gateway struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Addrs                []string `protobuf:"bytes,2,rep,name=addrs" json:"addrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Gateway) Reset()         { *m = Gateway{} }
+func (m *Gateway) String() string { return proto.CompactTextString(m) }
+func (*Gateway) ProtoMessage()    {}
+func (*Gateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{20}
+}
+func (m *Gateway) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Gateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Gateway.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Files) > 0 {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Files)))
-		i += copy(dAtA[i:], m.Files)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (dst *Gateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gateway.Merge(dst, src)
+}
+func (m *Gateway) XXX_Size() int {
+	return m.Size()
+}
+func (m *Gateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gateway proto.InternalMessageInfo
+
+func (m *Gateway) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return i, nil
+	return ""
 }
 
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Gateway) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Matcher != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Matcher.Size()))
-		n3, err := m.Matcher.MarshalTo(dAtA[i:])
+type Host struct {
+	Id                   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind                 HostKind    `protobuf:"varint,2,opt,name=kind,proto3,enum=meta.HostKind" json:"kind,omitempty"`
+	Value                string      `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Service              string      `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	ApiId                string      `protobuf:"bytes,5,opt,name=apiId,proto3" json:"apiId,omitempty"`
+	SvrId                string      `protobuf:"bytes,6,opt,name=svrId,proto3" json:"svrId,omitempty"`
+	ErrorPages           *ErrorPages `protobuf:"bytes,7,opt,name=errorPages" json:"errorPages,omitempty"`
+	Cors                 *CorsPolicy `protobuf:"bytes,8,opt,name=cors" json:"cors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Host) Reset()         { *m = Host{} }
+func (m *Host) String() string { return proto.CompactTextString(m) }
+func (*Host) ProtoMessage()    {}
+func (*Host) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{21}
+}
+func (m *Host) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Host) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Host.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n3
+		return b[:n], nil
 	}
-	if len(m.ErrorMsg) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.ErrorMsg)))
-		i += copy(dAtA[i:], m.ErrorMsg)
+}
+func (dst *Host) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Host.Merge(dst, src)
+}
+func (m *Host) XXX_Size() int {
+	return m.Size()
+}
+func (m *Host) XXX_DiscardUnknown() {
+	xxx_messageInfo_Host.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Host proto.InternalMessageInfo
+
+func (m *Host) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.Status != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Status))
+	return ""
+}
+
+func (m *Host) GetKind() HostKind {
+	if m != nil {
+		return m.Kind
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return HostKind_Allow
+}
+
+func (m *Host) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return i, nil
+	return ""
 }
 
-func (m *HeaderItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Host) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *HeaderItem) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+func (m *Host) GetApiId() string {
+	if m != nil {
+		return m.ApiId
 	}
-	if len(m.Key) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	return ""
+}
+
+func (m *Host) GetSvrId() string {
+	if m != nil {
+		return m.SvrId
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *Host) GetErrorPages() *ErrorPages {
+	if m != nil {
+		return m.ErrorPages
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ApiHeaders) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Host) GetCors() *CorsPolicy {
+	if m != nil {
+		return m.Cors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ApiHeaders) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToBackend) > 0 {
-		for _, msg := range m.ToBackend {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintMeta(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.ToClient) > 0 {
-		for _, msg := range m.ToClient {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintMeta(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+type Auth struct {
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind                 AuthKind          `protobuf:"varint,2,opt,name=kind,proto3,enum=meta.AuthKind" json:"kind,omitempty"`
+	Config               map[string]string `protobuf:"bytes,3,rep,name=config" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Auth) Reset()         { *m = Auth{} }
+func (m *Auth) String() string { return proto.CompactTextString(m) }
+func (*Auth) ProtoMessage()    {}
+func (*Auth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{22}
+}
+func (m *Auth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Auth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Auth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (dst *Auth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Auth.Merge(dst, src)
+}
+func (m *Auth) XXX_Size() int {
+	return m.Size()
+}
+func (m *Auth) XXX_DiscardUnknown() {
+	xxx_messageInfo_Auth.DiscardUnknown(m)
 }
 
-func (m *CookieItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Auth proto.InternalMessageInfo
+
+func (m *Auth) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CookieItem) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if len(m.Key) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
-	if m.Expire != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Expire))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Auth) GetKind() AuthKind {
+	if m != nil {
+		return m.Kind
 	}
-	return i, nil
+	return AuthKind_HttpBasic
 }
 
-func (m *ApiCookies) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Auth) GetConfig() map[string]string {
+	if m != nil {
+		return m.Config
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ApiCookies) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToBackend) > 0 {
-		for _, msg := range m.ToBackend {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintMeta(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+type IPAcl struct {
+	Id                   string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status               Status    `protobuf:"varint,2,opt,name=status,proto3,enum=meta.Status" json:"status,omitempty"`
+	Kind                 IPAclKind `protobuf:"varint,3,opt,name=kind,proto3,enum=meta.IPAclKind" json:"kind,omitempty"`
+	Cidrs                []string  `protobuf:"bytes,4,rep,name=cidrs" json:"cidrs,omitempty"`
+	Route                string    `protobuf:"bytes,5,opt,name=route,proto3" json:"route,omitempty"`
+	Service              string    `protobuf:"bytes,6,opt,name=service,proto3" json:"service,omitempty"`
+	ApiId                string    `protobuf:"bytes,7,opt,name=apiId,proto3" json:"apiId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *IPAcl) Reset()         { *m = IPAcl{} }
+func (m *IPAcl) String() string { return proto.CompactTextString(m) }
+func (*IPAcl) ProtoMessage()    {}
+func (*IPAcl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{23}
+}
+func (m *IPAcl) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IPAcl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IPAcl.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ToClient) > 0 {
-		for _, msg := range m.ToClient {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintMeta(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+}
+func (dst *IPAcl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IPAcl.Merge(dst, src)
+}
+func (m *IPAcl) XXX_Size() int {
+	return m.Size()
+}
+func (m *IPAcl) XXX_DiscardUnknown() {
+	xxx_messageInfo_IPAcl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IPAcl proto.InternalMessageInfo
+
+func (m *IPAcl) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *IPAcl) GetStatus() Status {
+	if m != nil {
+		return m.Status
 	}
-	return i, nil
+	return Status_Open
 }
 
-func (m *Api) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *IPAcl) GetKind() IPAclKind {
+	if m != nil {
+		return m.Kind
 	}
-	return dAtA[:n], nil
+	return IPAclKind_IPAllow
 }
 
-func (m *Api) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func (m *IPAcl) GetCidrs() []string {
+	if m != nil {
+		return m.Cidrs
 	}
-	if m.Status != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Status))
+	return nil
+}
+
+func (m *IPAcl) GetRoute() string {
+	if m != nil {
+		return m.Route
 	}
-	if len(m.Context) > 0 {
-		for k, _ := range m.Context {
-			dAtA[i] = 0x1a
-			i++
-			v := m.Context[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovMeta(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovMeta(uint64(len(k))) + msgSize
-			i = encodeVarintMeta(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintMeta(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintMeta(dAtA, i, uint64(v.Size()))
-				n4, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n4
-			}
-		}
+	return ""
+}
+
+func (m *IPAcl) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if len(m.AuthId) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.AuthId)))
-		i += copy(dAtA[i:], m.AuthId)
+	return ""
+}
+
+func (m *IPAcl) GetApiId() string {
+	if m != nil {
+		return m.ApiId
 	}
-	if m.Headers != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Headers.Size()))
-		n5, err := m.Headers.MarshalTo(dAtA[i:])
+	return ""
+}
+
+type ErrorPages struct {
+	Format               ErrorFormat       `protobuf:"varint,1,opt,name=format,proto3,enum=meta.ErrorFormat" json:"format,omitempty"`
+	Bodies               map[string]string `protobuf:"bytes,2,rep,name=bodies" json:"bodies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ErrorPages) Reset()         { *m = ErrorPages{} }
+func (m *ErrorPages) String() string { return proto.CompactTextString(m) }
+func (*ErrorPages) ProtoMessage()    {}
+func (*ErrorPages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{24}
+}
+func (m *ErrorPages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorPages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorPages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n5
+		return b[:n], nil
 	}
-	if m.Cookies != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Cookies.Size()))
-		n6, err := m.Cookies.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
-	}
-	if len(m.Validators) > 0 {
-		for _, msg := range m.Validators {
-			dAtA[i] = 0x3a
-			i++
-			i = encodeVarintMeta(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Path) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Path)))
-		i += copy(dAtA[i:], m.Path)
-	}
-	if len(m.Method) > 0 {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Method)))
-		i += copy(dAtA[i:], m.Method)
-	}
-	if len(m.Version) > 0 {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Version)))
-		i += copy(dAtA[i:], m.Version)
+}
+func (dst *ErrorPages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorPages.Merge(dst, src)
+}
+func (m *ErrorPages) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorPages) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorPages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorPages proto.InternalMessageInfo
+
+func (m *ErrorPages) GetFormat() ErrorFormat {
+	if m != nil {
+		return m.Format
 	}
-	if len(m.Lambda) > 0 {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Lambda)))
-		i += copy(dAtA[i:], m.Lambda)
+	return ErrorFormat_Text
+}
+
+func (m *ErrorPages) GetBodies() map[string]string {
+	if m != nil {
+		return m.Bodies
 	}
-	if len(m.ServerId) > 0 {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.ServerId)))
-		i += copy(dAtA[i:], m.ServerId)
+	return nil
+}
+
+type CacheConfig struct {
+	Disable              bool         `protobuf:"varint,1,opt,name=disable,proto3" json:"disable,omitempty"`
+	Ttl                  int64        `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Keys                 []*ValueItem `protobuf:"bytes,3,rep,name=keys" json:"keys,omitempty"`
+	StaleWhileRevalidate int64        `protobuf:"varint,4,opt,name=staleWhileRevalidate,proto3" json:"staleWhileRevalidate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CacheConfig) Reset()         { *m = CacheConfig{} }
+func (m *CacheConfig) String() string { return proto.CompactTextString(m) }
+func (*CacheConfig) ProtoMessage()    {}
+func (*CacheConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{25}
+}
+func (m *CacheConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (dst *CacheConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheConfig.Merge(dst, src)
+}
+func (m *CacheConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheConfig proto.InternalMessageInfo
+
+func (m *CacheConfig) GetDisable() bool {
+	if m != nil {
+		return m.Disable
 	}
-	return i, nil
+	return false
 }
 
-func (m *Service) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CacheConfig) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Service) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func (m *CacheConfig) GetKeys() []*ValueItem {
+	if m != nil {
+		return m.Keys
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	return nil
+}
+
+func (m *CacheConfig) GetStaleWhileRevalidate() int64 {
+	if m != nil {
+		return m.StaleWhileRevalidate
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+type CachePurge struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Host                 string   `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	ApiId                string   `protobuf:"bytes,4,opt,name=apiId,proto3" json:"apiId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CachePurge) Reset()         { *m = CachePurge{} }
+func (m *CachePurge) String() string { return proto.CompactTextString(m) }
+func (*CachePurge) ProtoMessage()    {}
+func (*CachePurge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{26}
+}
+func (m *CachePurge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CachePurge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CachePurge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *CachePurge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CachePurge.Merge(dst, src)
+}
+func (m *CachePurge) XXX_Size() int {
+	return m.Size()
+}
+func (m *CachePurge) XXX_DiscardUnknown() {
+	xxx_messageInfo_CachePurge.DiscardUnknown(m)
 }
 
-func (m *ServiceConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CachePurge proto.InternalMessageInfo
+
+func (m *CachePurge) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ServiceConfig) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func (m *CachePurge) GetHost() string {
+	if m != nil {
+		return m.Host
 	}
-	if m.Status != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Status))
+	return ""
+}
+
+func (m *CachePurge) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	if m.LoadBlance != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.LoadBlance))
+	return ""
+}
+
+func (m *CachePurge) GetApiId() string {
+	if m != nil {
+		return m.ApiId
 	}
-	if len(m.Context) > 0 {
-		for k, _ := range m.Context {
-			dAtA[i] = 0x22
-			i++
-			v := m.Context[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovMeta(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovMeta(uint64(len(k))) + msgSize
-			i = encodeVarintMeta(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintMeta(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintMeta(dAtA, i, uint64(v.Size()))
-				n7, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n7
-			}
+	return ""
+}
+
+type CorsPolicy struct {
+	AllowOrigins         []string `protobuf:"bytes,1,rep,name=allowOrigins" json:"allowOrigins,omitempty"`
+	AllowMethods         []string `protobuf:"bytes,2,rep,name=allowMethods" json:"allowMethods,omitempty"`
+	AllowHeaders         []string `protobuf:"bytes,3,rep,name=allowHeaders" json:"allowHeaders,omitempty"`
+	ExposeHeaders        []string `protobuf:"bytes,4,rep,name=exposeHeaders" json:"exposeHeaders,omitempty"`
+	AllowCredentials     bool     `protobuf:"varint,5,opt,name=allowCredentials,proto3" json:"allowCredentials,omitempty"`
+	MaxAge               int64    `protobuf:"varint,6,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CorsPolicy) Reset()         { *m = CorsPolicy{} }
+func (m *CorsPolicy) String() string { return proto.CompactTextString(m) }
+func (*CorsPolicy) ProtoMessage()    {}
+func (*CorsPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{27}
+}
+func (m *CorsPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CorsPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CorsPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.AuthId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.AuthId)))
-		i += copy(dAtA[i:], m.AuthId)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (dst *CorsPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorsPolicy.Merge(dst, src)
+}
+func (m *CorsPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *CorsPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorsPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorsPolicy proto.InternalMessageInfo
+
+func (m *CorsPolicy) GetAllowOrigins() []string {
+	if m != nil {
+		return m.AllowOrigins
 	}
-	return i, nil
+	return nil
 }
 
-func (m *HealthCheck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CorsPolicy) GetAllowMethods() []string {
+	if m != nil {
+		return m.AllowMethods
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *HealthCheck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Path) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Path)))
-		i += copy(dAtA[i:], m.Path)
-	}
-	if len(m.Body) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Body)))
-		i += copy(dAtA[i:], m.Body)
-	}
-	if m.Interval != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Interval))
-	}
-	if m.Timeout != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Timeout))
+func (m *CorsPolicy) GetAllowHeaders() []string {
+	if m != nil {
+		return m.AllowHeaders
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return nil
+}
+
+func (m *CorsPolicy) GetExposeHeaders() []string {
+	if m != nil {
+		return m.ExposeHeaders
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Server) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CorsPolicy) GetAllowCredentials() bool {
+	if m != nil {
+		return m.AllowCredentials
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Server) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func (m *CorsPolicy) GetMaxAge() int64 {
+	if m != nil {
+		return m.MaxAge
 	}
-	if m.Status != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Status))
+	return 0
+}
+
+type GrpcTranscode struct {
+	DescriptorName       string   `protobuf:"bytes,1,opt,name=descriptorName,proto3" json:"descriptorName,omitempty"`
+	Method               string   `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Body                 string   `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrpcTranscode) Reset()         { *m = GrpcTranscode{} }
+func (m *GrpcTranscode) String() string { return proto.CompactTextString(m) }
+func (*GrpcTranscode) ProtoMessage()    {}
+func (*GrpcTranscode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{28}
+}
+func (m *GrpcTranscode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrpcTranscode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrpcTranscode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+}
+func (dst *GrpcTranscode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrpcTranscode.Merge(dst, src)
+}
+func (m *GrpcTranscode) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrpcTranscode) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrpcTranscode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrpcTranscode proto.InternalMessageInfo
+
+func (m *GrpcTranscode) GetDescriptorName() string {
+	if m != nil {
+		return m.DescriptorName
 	}
-	if len(m.Host) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Host)))
-		i += copy(dAtA[i:], m.Host)
+	return ""
+}
+
+func (m *GrpcTranscode) GetMethod() string {
+	if m != nil {
+		return m.Method
 	}
-	if len(m.Addr) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Addr)))
-		i += copy(dAtA[i:], m.Addr)
+	return ""
+}
+
+func (m *GrpcTranscode) GetBody() string {
+	if m != nil {
+		return m.Body
 	}
-	if m.HealthCheck != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.HealthCheck.Size()))
-		n8, err := m.HealthCheck.MarshalTo(dAtA[i:])
+	return ""
+}
+
+type ProtoDescriptor struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	File                 string   `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProtoDescriptor) Reset()         { *m = ProtoDescriptor{} }
+func (m *ProtoDescriptor) String() string { return proto.CompactTextString(m) }
+func (*ProtoDescriptor) ProtoMessage()    {}
+func (*ProtoDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{29}
+}
+func (m *ProtoDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtoDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtoDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n8
+		return b[:n], nil
 	}
-	if m.MaxQPS != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.MaxQPS))
+}
+func (dst *ProtoDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtoDescriptor.Merge(dst, src)
+}
+func (m *ProtoDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtoDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtoDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtoDescriptor proto.InternalMessageInfo
+
+func (m *ProtoDescriptor) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *ProtoDescriptor) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return i, nil
+	return ""
 }
 
-func (m *Gateway) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ProtoDescriptor) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Gateway) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func (m *ProtoDescriptor) GetFile() string {
+	if m != nil {
+		return m.File
 	}
-	if len(m.Addrs) > 0 {
-		for _, s := range m.Addrs {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	return ""
+}
+
+type UpstreamClient struct {
+	Scheme               string   `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	CaCert               string   `protobuf:"bytes,2,opt,name=caCert,proto3" json:"caCert,omitempty"`
+	ClientCert           string   `protobuf:"bytes,3,opt,name=clientCert,proto3" json:"clientCert,omitempty"`
+	ClientKey            string   `protobuf:"bytes,4,opt,name=clientKey,proto3" json:"clientKey,omitempty"`
+	ServerName           string   `protobuf:"bytes,5,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	InsecureSkipVerify   bool     `protobuf:"varint,6,opt,name=insecureSkipVerify,proto3" json:"insecureSkipVerify,omitempty"`
+	ConnectTimeout       int64    `protobuf:"varint,7,opt,name=connectTimeout,proto3" json:"connectTimeout,omitempty"`
+	ReadTimeout          int64    `protobuf:"varint,8,opt,name=readTimeout,proto3" json:"readTimeout,omitempty"`
+	WriteTimeout         int64    `protobuf:"varint,9,opt,name=writeTimeout,proto3" json:"writeTimeout,omitempty"`
+	MaxConns             int64    `protobuf:"varint,10,opt,name=maxConns,proto3" json:"maxConns,omitempty"`
+	IdleConnTimeout      int64    `protobuf:"varint,11,opt,name=idleConnTimeout,proto3" json:"idleConnTimeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpstreamClient) Reset()         { *m = UpstreamClient{} }
+func (m *UpstreamClient) String() string { return proto.CompactTextString(m) }
+func (*UpstreamClient) ProtoMessage()    {}
+func (*UpstreamClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_meta_a700e13f33a4e3a1, []int{30}
+}
+func (m *UpstreamClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpstreamClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpstreamClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (dst *UpstreamClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamClient.Merge(dst, src)
+}
+func (m *UpstreamClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpstreamClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamClient.DiscardUnknown(m)
 }
 
-func (m *Host) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpstreamClient proto.InternalMessageInfo
+
+func (m *UpstreamClient) GetScheme() string {
+	if m != nil {
+		return m.Scheme
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Host) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func (m *UpstreamClient) GetCaCert() string {
+	if m != nil {
+		return m.CaCert
 	}
-	if m.Kind != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(m.Kind))
+	return ""
+}
+
+func (m *UpstreamClient) GetClientCert() string {
+	if m != nil {
+		return m.ClientCert
 	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+	return ""
+}
+
+func (m *UpstreamClient) GetClientKey() string {
+	if m != nil {
+		return m.ClientKey
 	}
-	if len(m.Service) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Service)))
-		i += copy(dAtA[i:], m.Service)
+	return ""
+}
+
+func (m *UpstreamClient) GetServerName() string {
+	if m != nil {
+		return m.ServerName
 	}
-	if len(m.ApiId) > 0 {
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *UpstreamClient) GetInsecureSkipVerify() bool {
+	if m != nil {
+		return m.InsecureSkipVerify
+	}
+	return false
+}
+
+func (m *UpstreamClient) GetConnectTimeout() int64 {
+	if m != nil {
+		return m.ConnectTimeout
+	}
+	return 0
+}
+
+func (m *UpstreamClient) GetReadTimeout() int64 {
+	if m != nil {
+		return m.ReadTimeout
+	}
+	return 0
+}
+
+func (m *UpstreamClient) GetWriteTimeout() int64 {
+	if m != nil {
+		return m.WriteTimeout
+	}
+	return 0
+}
+
+func (m *UpstreamClient) GetMaxConns() int64 {
+	if m != nil {
+		return m.MaxConns
+	}
+	return 0
+}
+
+func (m *UpstreamClient) GetIdleConnTimeout() int64 {
+	if m != nil {
+		return m.IdleConnTimeout
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ValueItem)(nil), "meta.ValueItem")
+	proto.RegisterType((*Matcher)(nil), "meta.Matcher")
+	proto.RegisterType((*ApiCondition)(nil), "meta.ApiCondition")
+	proto.RegisterType((*WeightedApi)(nil), "meta.WeightedApi")
+	proto.RegisterType((*Mirror)(nil), "meta.Mirror")
+	proto.RegisterType((*ApiVersioning)(nil), "meta.ApiVersioning")
+	proto.RegisterType((*ApiDeprecation)(nil), "meta.ApiDeprecation")
+	proto.RegisterType((*TrafficSplit)(nil), "meta.TrafficSplit")
+	proto.RegisterType((*Route)(nil), "meta.Route")
+	proto.RegisterMapType((map[string]*ValueItem)(nil), "meta.Route.ContextEntry")
+	proto.RegisterType((*Validator)(nil), "meta.Validator")
+	proto.RegisterType((*HeaderItem)(nil), "meta.HeaderItem")
+	proto.RegisterType((*ApiHeaders)(nil), "meta.ApiHeaders")
+	proto.RegisterType((*CookieItem)(nil), "meta.CookieItem")
+	proto.RegisterType((*ApiCookies)(nil), "meta.ApiCookies")
+	proto.RegisterType((*Api)(nil), "meta.Api")
+	proto.RegisterMapType((map[string]*ValueItem)(nil), "meta.Api.ContextEntry")
+	proto.RegisterType((*Service)(nil), "meta.Service")
+	proto.RegisterType((*ServiceConfig)(nil), "meta.ServiceConfig")
+	proto.RegisterMapType((map[string]*ValueItem)(nil), "meta.ServiceConfig.ContextEntry")
+	proto.RegisterType((*ProxyHeaders)(nil), "meta.ProxyHeaders")
+	proto.RegisterType((*HealthCheck)(nil), "meta.HealthCheck")
+	proto.RegisterType((*Server)(nil), "meta.Server")
+	proto.RegisterType((*Gateway)(nil), "meta.Gateway")
+	proto.RegisterType((*Host)(nil), "meta.Host")
+	proto.RegisterType((*Auth)(nil), "meta.Auth")
+	proto.RegisterMapType((map[string]string)(nil), "meta.Auth.ConfigEntry")
+	proto.RegisterType((*IPAcl)(nil), "meta.IPAcl")
+	proto.RegisterType((*ErrorPages)(nil), "meta.ErrorPages")
+	proto.RegisterMapType((map[string]string)(nil), "meta.ErrorPages.BodiesEntry")
+	proto.RegisterType((*CacheConfig)(nil), "meta.CacheConfig")
+	proto.RegisterType((*CachePurge)(nil), "meta.CachePurge")
+	proto.RegisterType((*CorsPolicy)(nil), "meta.CorsPolicy")
+	proto.RegisterType((*GrpcTranscode)(nil), "meta.GrpcTranscode")
+	proto.RegisterType((*ProtoDescriptor)(nil), "meta.ProtoDescriptor")
+	proto.RegisterType((*UpstreamClient)(nil), "meta.UpstreamClient")
+	proto.RegisterEnum("meta.ValueSource", ValueSource_name, ValueSource_value)
+	proto.RegisterEnum("meta.MatcherKind", MatcherKind_name, MatcherKind_value)
+	proto.RegisterEnum("meta.VersionSource", VersionSource_name, VersionSource_value)
+	proto.RegisterEnum("meta.Status", Status_name, Status_value)
+	proto.RegisterEnum("meta.LoadBalance", LoadBalance_name, LoadBalance_value)
+	proto.RegisterEnum("meta.ServerProtocol", ServerProtocol_name, ServerProtocol_value)
+	proto.RegisterEnum("meta.HostKind", HostKind_name, HostKind_value)
+	proto.RegisterEnum("meta.AuthKind", AuthKind_name, AuthKind_value)
+	proto.RegisterEnum("meta.IPAclKind", IPAclKind_name, IPAclKind_value)
+	proto.RegisterEnum("meta.ErrorFormat", ErrorFormat_name, ErrorFormat_value)
+}
+func (m *ValueItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValueItem) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Source != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.ApiId)))
-		i += copy(dAtA[i:], m.ApiId)
+		i = encodeVarintMeta(dAtA, i, uint64(m.Source))
 	}
-	if len(m.SvrId) > 0 {
-		dAtA[i] = 0x32
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.SvrId)))
-		i += copy(dAtA[i:], m.SvrId)
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2306,7 +2826,7 @@ func (m *Host) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Auth) Marshal() (dAtA []byte, err error) {
+func (m *Matcher) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2316,38 +2836,27 @@ func (m *Auth) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Auth) MarshalTo(dAtA []byte) (int, error) {
+func (m *Matcher) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
+	if len(m.Key) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
 	if m.Kind != 0 {
 		dAtA[i] = 0x10
 		i++
 		i = encodeVarintMeta(dAtA, i, uint64(m.Kind))
 	}
-	if len(m.Config) > 0 {
-		for k, _ := range m.Config {
-			dAtA[i] = 0x1a
-			i++
-			v := m.Config[k]
-			mapSize := 1 + len(k) + sovMeta(uint64(len(k))) + 1 + len(v) + sovMeta(uint64(len(v)))
-			i = encodeVarintMeta(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintMeta(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintMeta(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2355,522 +2864,6040 @@ func (m *Auth) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintMeta(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ApiCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *ValueItem) Size() (n int) {
+
+func (m *ApiCondition) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Source != 0 {
-		n += 1 + sovMeta(uint64(m.Source))
+	if m.Matcher != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Matcher.Size()))
+		n1, err := m.Matcher.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.ApiId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.ApiId)))
+		i += copy(dAtA[i:], m.ApiId)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Matcher) Size() (n int) {
+func (m *WeightedApi) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedApi) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	if m.Kind != 0 {
-		n += 1 + sovMeta(uint64(m.Kind))
+	if len(m.ApiId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.ApiId)))
+		i += copy(dAtA[i:], m.ApiId)
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if m.Weight != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Weight))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ApiCondition) Size() (n int) {
+func (m *Mirror) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Mirror) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Matcher != nil {
-		l = m.Matcher.Size()
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.Service) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Service)))
+		i += copy(dAtA[i:], m.Service)
 	}
-	l = len(m.ApiId)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.ServerId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.ServerId)))
+		i += copy(dAtA[i:], m.ServerId)
+	}
+	if m.Percent != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Percent))
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Timeout))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Route) Size() (n int) {
+func (m *ApiVersioning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApiVersioning) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovMeta(uint64(m.Status))
-	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	l = len(m.Method)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	l = len(m.ApiId)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	if len(m.Context) > 0 {
-		for k, v := range m.Context {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovMeta(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovMeta(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovMeta(uint64(mapEntrySize))
-		}
+	if m.Source != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Source))
 	}
-	if len(m.ApiConds) > 0 {
-		for _, e := range m.ApiConds {
-			l = e.Size()
-			n += 1 + l + sovMeta(uint64(l))
-		}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	l = len(m.Files)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.DefaultVersion) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.DefaultVersion)))
+		i += copy(dAtA[i:], m.DefaultVersion)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Validator) Size() (n int) {
-	var l int
-	_ = l
-	if m.Matcher != nil {
-		l = m.Matcher.Size()
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	l = len(m.ErrorMsg)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovMeta(uint64(m.Status))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ApiDeprecation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *HeaderItem) Size() (n int) {
+func (m *ApiDeprecation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.Date) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Date)))
+		i += copy(dAtA[i:], m.Date)
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.Sunset) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Sunset)))
+		i += copy(dAtA[i:], m.Sunset)
+	}
+	if len(m.Link) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Link)))
+		i += copy(dAtA[i:], m.Link)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ApiHeaders) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.ToBackend) > 0 {
-		for _, e := range m.ToBackend {
-			l = e.Size()
-			n += 1 + l + sovMeta(uint64(l))
-		}
-	}
-	if len(m.ToClient) > 0 {
-		for _, e := range m.ToClient {
-			l = e.Size()
-			n += 1 + l + sovMeta(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *TrafficSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CookieItem) Size() (n int) {
+func (m *TrafficSplit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.Apis) > 0 {
+		for _, msg := range m.Apis {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.Cookie) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Cookie)))
+		i += copy(dAtA[i:], m.Cookie)
 	}
-	if m.Expire != 0 {
-		n += 1 + sovMeta(uint64(m.Expire))
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ApiCookies) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.ToBackend) > 0 {
-		for _, e := range m.ToBackend {
-			l = e.Size()
-			n += 1 + l + sovMeta(uint64(l))
-		}
-	}
-	if len(m.ToClient) > 0 {
-		for _, e := range m.ToClient {
-			l = e.Size()
-			n += 1 + l + sovMeta(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Route) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Api) Size() (n int) {
+func (m *Route) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
 	}
 	if m.Status != 0 {
-		n += 1 + sovMeta(uint64(m.Status))
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Status))
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if len(m.Method) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Method)))
+		i += copy(dAtA[i:], m.Method)
+	}
+	if len(m.Service) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Service)))
+		i += copy(dAtA[i:], m.Service)
+	}
+	if len(m.ApiId) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.ApiId)))
+		i += copy(dAtA[i:], m.ApiId)
 	}
 	if len(m.Context) > 0 {
-		for k, v := range m.Context {
-			_ = k
-			_ = v
-			l = 0
+		for k, _ := range m.Context {
+			dAtA[i] = 0x3a
+			i++
+			v := m.Context[k]
+			msgSize := 0
 			if v != nil {
-				l = v.Size()
-				l += 1 + sovMeta(uint64(l))
+				msgSize = v.Size()
+				msgSize += 1 + sovMeta(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovMeta(uint64(len(k))) + msgSize
+			i = encodeVarintMeta(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintMeta(dAtA, i, uint64(v.Size()))
+				n2, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n2
 			}
-			mapEntrySize := 1 + len(k) + sovMeta(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovMeta(uint64(mapEntrySize))
 		}
 	}
-	l = len(m.AuthId)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	if m.Cookies != nil {
-		l = m.Cookies.Size()
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovMeta(uint64(l))
+	if len(m.ApiConds) > 0 {
+		for _, msg := range m.ApiConds {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.Files) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Files)))
+		i += copy(dAtA[i:], m.Files)
 	}
-	l = len(m.Method)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.HostId) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.HostId)))
+		i += copy(dAtA[i:], m.HostId)
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if m.Split != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Split.Size()))
+		n3, err := m.Split.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
 	}
-	l = len(m.Lambda)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if m.Mirror != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Mirror.Size()))
+		n4, err := m.Mirror.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
-	l = len(m.ServerId)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if m.Versioning != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Versioning.Size()))
+		n5, err := m.Versioning.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Service) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ServiceConfig) Size() (n int) {
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovMeta(uint64(m.Status))
-	}
-	if m.LoadBlance != 0 {
-		n += 1 + sovMeta(uint64(m.LoadBlance))
-	}
-	if len(m.Context) > 0 {
-		for k, v := range m.Context {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovMeta(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovMeta(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovMeta(uint64(mapEntrySize))
+	if m.Matcher != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Matcher.Size()))
+		n6, err := m.Matcher.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n6
 	}
-	l = len(m.AuthId)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.ErrorMsg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.ErrorMsg)))
+		i += copy(dAtA[i:], m.ErrorMsg)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Status))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *HealthCheck) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	l = len(m.Body)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	if m.Interval != 0 {
-		n += 1 + sovMeta(uint64(m.Interval))
-	}
-	if m.Timeout != 0 {
-		n += 1 + sovMeta(uint64(m.Timeout))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *HeaderItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Server) Size() (n int) {
+func (m *HeaderItem) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovMeta(uint64(m.Status))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	l = len(m.Host)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	if m.HealthCheck != nil {
-		l = m.HealthCheck.Size()
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.MaxQPS != 0 {
-		n += 1 + sovMeta(uint64(m.MaxQPS))
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Gateway) Size() (n int) {
+func (m *ApiHeaders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApiHeaders) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.ToBackend) > 0 {
+		for _, msg := range m.ToBackend {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if len(m.Addrs) > 0 {
-		for _, s := range m.Addrs {
-			l = len(s)
-			n += 1 + l + sovMeta(uint64(l))
+	if len(m.ToClient) > 0 {
+		for _, msg := range m.ToClient {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Host) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	if m.Kind != 0 {
-		n += 1 + sovMeta(uint64(m.Kind))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+func (m *CookieItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *CookieItem) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	l = len(m.ApiId)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
-	l = len(m.SvrId)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
+	if m.Expire != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Expire))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Auth) Size() (n int) {
+func (m *ApiCookies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApiCookies) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMeta(uint64(l))
-	}
-	if m.Kind != 0 {
-		n += 1 + sovMeta(uint64(m.Kind))
+	if len(m.ToBackend) > 0 {
+		for _, msg := range m.ToBackend {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if len(m.Config) > 0 {
-		for k, v := range m.Config {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovMeta(uint64(len(k))) + 1 + len(v) + sovMeta(uint64(len(v)))
-			n += mapEntrySize + 1 + sovMeta(uint64(mapEntrySize))
+	if len(m.ToClient) > 0 {
+		for _, msg := range m.ToClient {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func sovMeta(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *Api) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
-}
-func sozMeta(x uint64) (n int) {
-	return sovMeta(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return dAtA[:n], nil
 }
-func (m *ValueItem) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMeta
+
+func (m *Api) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Status))
+	}
+	if len(m.Context) > 0 {
+		for k, _ := range m.Context {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Context[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovMeta(uint64(msgSize))
 			}
-			if iNdEx >= l {
+			mapSize := 1 + len(k) + sovMeta(uint64(len(k))) + msgSize
+			i = encodeVarintMeta(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintMeta(dAtA, i, uint64(v.Size()))
+				n7, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n7
+			}
+		}
+	}
+	if len(m.AuthId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.AuthId)))
+		i += copy(dAtA[i:], m.AuthId)
+	}
+	if m.Headers != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Headers.Size()))
+		n8, err := m.Headers.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.Cookies != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Cookies.Size()))
+		n9, err := m.Cookies.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if len(m.Validators) > 0 {
+		for _, msg := range m.Validators {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if len(m.Method) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Method)))
+		i += copy(dAtA[i:], m.Method)
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.Lambda) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Lambda)))
+		i += copy(dAtA[i:], m.Lambda)
+	}
+	if len(m.ServerId) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.ServerId)))
+		i += copy(dAtA[i:], m.ServerId)
+	}
+	if m.ErrorPages != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.ErrorPages.Size()))
+		n10, err := m.ErrorPages.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.Cache != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Cache.Size()))
+		n11, err := m.Cache.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.DisableCompression {
+		dAtA[i] = 0x78
+		i++
+		if m.DisableCompression {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Cors != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Cors.Size()))
+		n12, err := m.Cors.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.Stream {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Stream {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MaxBodySize != 0 {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.MaxBodySize))
+	}
+	if m.Grpc != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Grpc.Size()))
+		n13, err := m.Grpc.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Timeout))
+	}
+	if m.Mirror != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Mirror.Size()))
+		n14, err := m.Mirror.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.Deprecation != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Deprecation.Size()))
+		n15, err := m.Deprecation.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Service) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Service) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ServiceConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceConfig) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Status))
+	}
+	if m.LoadBlance != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.LoadBlance))
+	}
+	if len(m.Context) > 0 {
+		for k, _ := range m.Context {
+			dAtA[i] = 0x22
+			i++
+			v := m.Context[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovMeta(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovMeta(uint64(len(k))) + msgSize
+			i = encodeVarintMeta(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintMeta(dAtA, i, uint64(v.Size()))
+				n16, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n16
+			}
+		}
+	}
+	if len(m.AuthId) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.AuthId)))
+		i += copy(dAtA[i:], m.AuthId)
+	}
+	if m.ProxyHeaders != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.ProxyHeaders.Size()))
+		n17, err := m.ProxyHeaders.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.ErrorPages != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.ErrorPages.Size()))
+		n18, err := m.ErrorPages.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.Cors != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Cors.Size()))
+		n19, err := m.Cors.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if m.Client != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Client.Size()))
+		n20, err := m.Client.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Timeout))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ProxyHeaders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProxyHeaders) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.DisableXForwarded {
+		dAtA[i] = 0x8
+		i++
+		if m.DisableXForwarded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DisableXRealIp {
+		dAtA[i] = 0x10
+		i++
+		if m.DisableXRealIp {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DisableVia {
+		dAtA[i] = 0x18
+		i++
+		if m.DisableVia {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Forwarded {
+		dAtA[i] = 0x20
+		i++
+		if m.Forwarded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Via) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Via)))
+		i += copy(dAtA[i:], m.Via)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *HealthCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthCheck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if len(m.Body) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Body)))
+		i += copy(dAtA[i:], m.Body)
+	}
+	if m.Interval != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Interval))
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Timeout))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Server) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Server) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Status))
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Host) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Host)))
+		i += copy(dAtA[i:], m.Host)
+	}
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if m.HealthCheck != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.HealthCheck.Size()))
+		n21, err := m.HealthCheck.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if m.MaxQPS != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.MaxQPS))
+	}
+	if m.Protocol != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Protocol))
+	}
+	if m.Client != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Client.Size()))
+		n22, err := m.Client.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Gateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gateway) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Host) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Host) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Kind != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Kind))
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if len(m.Service) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Service)))
+		i += copy(dAtA[i:], m.Service)
+	}
+	if len(m.ApiId) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.ApiId)))
+		i += copy(dAtA[i:], m.ApiId)
+	}
+	if len(m.SvrId) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.SvrId)))
+		i += copy(dAtA[i:], m.SvrId)
+	}
+	if m.ErrorPages != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.ErrorPages.Size()))
+		n23, err := m.ErrorPages.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if m.Cors != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Cors.Size()))
+		n24, err := m.Cors.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Auth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Auth) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Kind != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Kind))
+	}
+	if len(m.Config) > 0 {
+		for k, _ := range m.Config {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Config[k]
+			mapSize := 1 + len(k) + sovMeta(uint64(len(k))) + 1 + len(v) + sovMeta(uint64(len(v)))
+			i = encodeVarintMeta(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *IPAcl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IPAcl) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Status))
+	}
+	if m.Kind != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Kind))
+	}
+	if len(m.Cidrs) > 0 {
+		for _, s := range m.Cidrs {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Route) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Route)))
+		i += copy(dAtA[i:], m.Route)
+	}
+	if len(m.Service) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Service)))
+		i += copy(dAtA[i:], m.Service)
+	}
+	if len(m.ApiId) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.ApiId)))
+		i += copy(dAtA[i:], m.ApiId)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ErrorPages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorPages) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Format != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Format))
+	}
+	if len(m.Bodies) > 0 {
+		for k, _ := range m.Bodies {
+			dAtA[i] = 0x12
+			i++
+			v := m.Bodies[k]
+			mapSize := 1 + len(k) + sovMeta(uint64(len(k))) + 1 + len(v) + sovMeta(uint64(len(v)))
+			i = encodeVarintMeta(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CacheConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheConfig) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Disable {
+		dAtA[i] = 0x8
+		i++
+		if m.Disable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Ttl != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.Ttl))
+	}
+	if len(m.Keys) > 0 {
+		for _, msg := range m.Keys {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMeta(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.StaleWhileRevalidate != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.StaleWhileRevalidate))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CachePurge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CachePurge) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Host) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Host)))
+		i += copy(dAtA[i:], m.Host)
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if len(m.ApiId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.ApiId)))
+		i += copy(dAtA[i:], m.ApiId)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CorsPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CorsPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowOrigins) > 0 {
+		for _, s := range m.AllowOrigins {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.AllowMethods) > 0 {
+		for _, s := range m.AllowMethods {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.AllowHeaders) > 0 {
+		for _, s := range m.AllowHeaders {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ExposeHeaders) > 0 {
+		for _, s := range m.ExposeHeaders {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.AllowCredentials {
+		dAtA[i] = 0x28
+		i++
+		if m.AllowCredentials {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MaxAge != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.MaxAge))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GrpcTranscode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrpcTranscode) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.DescriptorName) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.DescriptorName)))
+		i += copy(dAtA[i:], m.DescriptorName)
+	}
+	if len(m.Method) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Method)))
+		i += copy(dAtA[i:], m.Method)
+	}
+	if len(m.Body) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Body)))
+		i += copy(dAtA[i:], m.Body)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ProtoDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtoDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if len(m.File) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.File)))
+		i += copy(dAtA[i:], m.File)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *UpstreamClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpstreamClient) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scheme) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Scheme)))
+		i += copy(dAtA[i:], m.Scheme)
+	}
+	if len(m.CaCert) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.CaCert)))
+		i += copy(dAtA[i:], m.CaCert)
+	}
+	if len(m.ClientCert) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.ClientCert)))
+		i += copy(dAtA[i:], m.ClientCert)
+	}
+	if len(m.ClientKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.ClientKey)))
+		i += copy(dAtA[i:], m.ClientKey)
+	}
+	if len(m.ServerName) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.ServerName)))
+		i += copy(dAtA[i:], m.ServerName)
+	}
+	if m.InsecureSkipVerify {
+		dAtA[i] = 0x30
+		i++
+		if m.InsecureSkipVerify {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ConnectTimeout != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.ConnectTimeout))
+	}
+	if m.ReadTimeout != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.ReadTimeout))
+	}
+	if m.WriteTimeout != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.WriteTimeout))
+	}
+	if m.MaxConns != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.MaxConns))
+	}
+	if m.IdleConnTimeout != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.IdleConnTimeout))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintMeta(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ValueItem) Size() (n int) {
+	var l int
+	_ = l
+	if m.Source != 0 {
+		n += 1 + sovMeta(uint64(m.Source))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Matcher) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovMeta(uint64(m.Kind))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApiCondition) Size() (n int) {
+	var l int
+	_ = l
+	if m.Matcher != nil {
+		l = m.Matcher.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.ApiId)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WeightedApi) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ApiId)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovMeta(uint64(m.Weight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Mirror) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.ServerId)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Percent != 0 {
+		n += 1 + sovMeta(uint64(m.Percent))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovMeta(uint64(m.Timeout))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApiVersioning) Size() (n int) {
+	var l int
+	_ = l
+	if m.Source != 0 {
+		n += 1 + sovMeta(uint64(m.Source))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.DefaultVersion)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApiDeprecation) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Sunset)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Link)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrafficSplit) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Apis) > 0 {
+		for _, e := range m.Apis {
+			l = e.Size()
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	l = len(m.Cookie)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Route) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMeta(uint64(m.Status))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.ApiId)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if len(m.Context) > 0 {
+		for k, v := range m.Context {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovMeta(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovMeta(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMeta(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ApiConds) > 0 {
+		for _, e := range m.ApiConds {
+			l = e.Size()
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	l = len(m.Files)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.HostId)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Split != nil {
+		l = m.Split.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Mirror != nil {
+		l = m.Mirror.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Versioning != nil {
+		l = m.Versioning.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	var l int
+	_ = l
+	if m.Matcher != nil {
+		l = m.Matcher.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.ErrorMsg)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMeta(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeaderItem) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApiHeaders) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ToBackend) > 0 {
+		for _, e := range m.ToBackend {
+			l = e.Size()
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	if len(m.ToClient) > 0 {
+		for _, e := range m.ToClient {
+			l = e.Size()
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CookieItem) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Expire != 0 {
+		n += 1 + sovMeta(uint64(m.Expire))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApiCookies) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ToBackend) > 0 {
+		for _, e := range m.ToBackend {
+			l = e.Size()
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	if len(m.ToClient) > 0 {
+		for _, e := range m.ToClient {
+			l = e.Size()
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Api) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMeta(uint64(m.Status))
+	}
+	if len(m.Context) > 0 {
+		for k, v := range m.Context {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovMeta(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovMeta(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMeta(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.AuthId)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Headers != nil {
+		l = m.Headers.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Cookies != nil {
+		l = m.Cookies.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Lambda)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.ServerId)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.ErrorPages != nil {
+		l = m.ErrorPages.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Cache != nil {
+		l = m.Cache.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.DisableCompression {
+		n += 2
+	}
+	if m.Cors != nil {
+		l = m.Cors.Size()
+		n += 2 + l + sovMeta(uint64(l))
+	}
+	if m.Stream {
+		n += 3
+	}
+	if m.MaxBodySize != 0 {
+		n += 2 + sovMeta(uint64(m.MaxBodySize))
+	}
+	if m.Grpc != nil {
+		l = m.Grpc.Size()
+		n += 2 + l + sovMeta(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 2 + sovMeta(uint64(m.Timeout))
+	}
+	if m.Mirror != nil {
+		l = m.Mirror.Size()
+		n += 2 + l + sovMeta(uint64(l))
+	}
+	if m.Deprecation != nil {
+		l = m.Deprecation.Size()
+		n += 2 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Service) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServiceConfig) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMeta(uint64(m.Status))
+	}
+	if m.LoadBlance != 0 {
+		n += 1 + sovMeta(uint64(m.LoadBlance))
+	}
+	if len(m.Context) > 0 {
+		for k, v := range m.Context {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovMeta(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovMeta(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMeta(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.AuthId)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.ProxyHeaders != nil {
+		l = m.ProxyHeaders.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.ErrorPages != nil {
+		l = m.ErrorPages.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Cors != nil {
+		l = m.Cors.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Client != nil {
+		l = m.Client.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovMeta(uint64(m.Timeout))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProxyHeaders) Size() (n int) {
+	var l int
+	_ = l
+	if m.DisableXForwarded {
+		n += 2
+	}
+	if m.DisableXRealIp {
+		n += 2
+	}
+	if m.DisableVia {
+		n += 2
+	}
+	if m.Forwarded {
+		n += 2
+	}
+	l = len(m.Via)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HealthCheck) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovMeta(uint64(m.Interval))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovMeta(uint64(m.Timeout))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Server) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMeta(uint64(m.Status))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.HealthCheck != nil {
+		l = m.HealthCheck.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.MaxQPS != 0 {
+		n += 1 + sovMeta(uint64(m.MaxQPS))
+	}
+	if m.Protocol != 0 {
+		n += 1 + sovMeta(uint64(m.Protocol))
+	}
+	if m.Client != nil {
+		l = m.Client.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Gateway) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			l = len(s)
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Host) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovMeta(uint64(m.Kind))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.ApiId)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.SvrId)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.ErrorPages != nil {
+		l = m.ErrorPages.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Cors != nil {
+		l = m.Cors.Size()
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Auth) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovMeta(uint64(m.Kind))
+	}
+	if len(m.Config) > 0 {
+		for k, v := range m.Config {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMeta(uint64(len(k))) + 1 + len(v) + sovMeta(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMeta(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IPAcl) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMeta(uint64(m.Status))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovMeta(uint64(m.Kind))
+	}
+	if len(m.Cidrs) > 0 {
+		for _, s := range m.Cidrs {
+			l = len(s)
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.ApiId)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ErrorPages) Size() (n int) {
+	var l int
+	_ = l
+	if m.Format != 0 {
+		n += 1 + sovMeta(uint64(m.Format))
+	}
+	if len(m.Bodies) > 0 {
+		for k, v := range m.Bodies {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMeta(uint64(len(k))) + 1 + len(v) + sovMeta(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMeta(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CacheConfig) Size() (n int) {
+	var l int
+	_ = l
+	if m.Disable {
+		n += 2
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovMeta(uint64(m.Ttl))
+	}
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	if m.StaleWhileRevalidate != 0 {
+		n += 1 + sovMeta(uint64(m.StaleWhileRevalidate))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CachePurge) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.ApiId)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CorsPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.AllowOrigins) > 0 {
+		for _, s := range m.AllowOrigins {
+			l = len(s)
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	if len(m.AllowMethods) > 0 {
+		for _, s := range m.AllowMethods {
+			l = len(s)
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	if len(m.AllowHeaders) > 0 {
+		for _, s := range m.AllowHeaders {
+			l = len(s)
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	if len(m.ExposeHeaders) > 0 {
+		for _, s := range m.ExposeHeaders {
+			l = len(s)
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	if m.AllowCredentials {
+		n += 2
+	}
+	if m.MaxAge != 0 {
+		n += 1 + sovMeta(uint64(m.MaxAge))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GrpcTranscode) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DescriptorName)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProtoDescriptor) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.File)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpstreamClient) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Scheme)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.CaCert)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.ClientCert)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.ClientKey)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	l = len(m.ServerName)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.InsecureSkipVerify {
+		n += 2
+	}
+	if m.ConnectTimeout != 0 {
+		n += 1 + sovMeta(uint64(m.ConnectTimeout))
+	}
+	if m.ReadTimeout != 0 {
+		n += 1 + sovMeta(uint64(m.ReadTimeout))
+	}
+	if m.WriteTimeout != 0 {
+		n += 1 + sovMeta(uint64(m.WriteTimeout))
+	}
+	if m.MaxConns != 0 {
+		n += 1 + sovMeta(uint64(m.MaxConns))
+	}
+	if m.IdleConnTimeout != 0 {
+		n += 1 + sovMeta(uint64(m.IdleConnTimeout))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMeta(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozMeta(x uint64) (n int) {
+	return sovMeta(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValueItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= (ValueSource(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Matcher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Matcher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Matcher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= (MatcherKind(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApiCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApiCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApiCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matcher", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Matcher == nil {
+				m.Matcher = &Matcher{}
+			}
+			if err := m.Matcher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedApi) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedApi: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedApi: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Mirror) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mirror: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mirror: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+			}
+			m.Percent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percent |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApiVersioning) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApiVersioning: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApiVersioning: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= (VersionSource(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApiDeprecation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApiDeprecation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApiDeprecation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sunset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sunset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Link = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrafficSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrafficSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrafficSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Apis = append(m.Apis, &WeightedApi{})
+			if err := m.Apis[len(m.Apis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cookie", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cookie = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Route) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Route: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Route: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = make(map[string]*ValueItem)
+			}
+			var mapkey string
+			var mapvalue *ValueItem
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMeta
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMeta
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMeta
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMeta
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMeta
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMeta
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ValueItem{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMeta(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMeta
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Context[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiConds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiConds = append(m.ApiConds, &ApiCondition{})
+			if err := m.ApiConds[len(m.ApiConds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Files = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Split == nil {
+				m.Split = &TrafficSplit{}
+			}
+			if err := m.Split.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mirror", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mirror == nil {
+				m.Mirror = &Mirror{}
+			}
+			if err := m.Mirror.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versioning", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Versioning == nil {
+				m.Versioning = &ApiVersioning{}
+			}
+			if err := m.Versioning.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matcher", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Matcher == nil {
+				m.Matcher = &Matcher{}
+			}
+			if err := m.Matcher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMsg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMsg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeaderItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApiHeaders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApiHeaders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApiHeaders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBackend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToBackend = append(m.ToBackend, &HeaderItem{})
+			if err := m.ToBackend[len(m.ToBackend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToClient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToClient = append(m.ToClient, &HeaderItem{})
+			if err := m.ToClient[len(m.ToClient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CookieItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CookieItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CookieItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expire", wireType)
+			}
+			m.Expire = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expire |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApiCookies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApiCookies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApiCookies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBackend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToBackend = append(m.ToBackend, &CookieItem{})
+			if err := m.ToBackend[len(m.ToBackend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToClient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToClient = append(m.ToClient, &CookieItem{})
+			if err := m.ToClient[len(m.ToClient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Api) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Api: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Api: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = make(map[string]*ValueItem)
+			}
+			var mapkey string
+			var mapvalue *ValueItem
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMeta
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMeta
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMeta
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMeta
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMeta
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMeta
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ValueItem{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMeta(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMeta
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Context[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = &ApiHeaders{}
+			}
+			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cookies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cookies == nil {
+				m.Cookies = &ApiCookies{}
+			}
+			if err := m.Cookies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lambda", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lambda = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorPages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ErrorPages == nil {
+				m.ErrorPages = &ErrorPages{}
+			}
+			if err := m.ErrorPages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cache == nil {
+				m.Cache = &CacheConfig{}
+			}
+			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableCompression", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableCompression = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cors == nil {
+				m.Cors = &CorsPolicy{}
+			}
+			if err := m.Cors.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stream = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBodySize", wireType)
+			}
+			m.MaxBodySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBodySize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grpc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Grpc == nil {
+				m.Grpc = &GrpcTranscode{}
+			}
+			if err := m.Grpc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mirror", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mirror == nil {
+				m.Mirror = &Mirror{}
+			}
+			if err := m.Mirror.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deprecation == nil {
+				m.Deprecation = &ApiDeprecation{}
+			}
+			if err := m.Deprecation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Service) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadBlance", wireType)
+			}
+			m.LoadBlance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoadBlance |= (LoadBalance(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = make(map[string]*ValueItem)
+			}
+			var mapkey string
+			var mapvalue *ValueItem
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMeta
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMeta
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMeta
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMeta
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMeta
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMeta
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ValueItem{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMeta(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMeta
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Context[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProxyHeaders == nil {
+				m.ProxyHeaders = &ProxyHeaders{}
+			}
+			if err := m.ProxyHeaders.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorPages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ErrorPages == nil {
+				m.ErrorPages = &ErrorPages{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValueItem: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueItem: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			if err := m.ErrorPages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Source = 0
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cors", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -2880,16 +8907,30 @@ func (m *ValueItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= (ValueSource(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cors == nil {
+				m.Cors = &CorsPolicy{}
+			}
+			if err := m.Cors.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -2899,21 +8940,44 @@ func (m *ValueItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMeta
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Client == nil {
+				m.Client = &UpstreamClient{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -2936,7 +9000,7 @@ func (m *ValueItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Matcher) Unmarshal(dAtA []byte) error {
+func (m *ProxyHeaders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2959,17 +9023,17 @@ func (m *Matcher) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Matcher: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProxyHeaders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Matcher: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProxyHeaders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableXForwarded", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -2979,26 +9043,17 @@ func (m *Matcher) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.DisableXForwarded = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableXRealIp", wireType)
 			}
-			m.Kind = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3008,14 +9063,55 @@ func (m *Matcher) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Kind |= (MatcherKind(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DisableXRealIp = bool(v != 0)
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableVia", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableVia = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Forwarded = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Via", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3040,7 +9136,7 @@ func (m *Matcher) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Via = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3064,7 +9160,7 @@ func (m *Matcher) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApiCondition) Unmarshal(dAtA []byte) error {
+func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3087,17 +9183,17 @@ func (m *ApiCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApiCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthCheck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApiCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthCheck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Matcher", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3107,28 +9203,24 @@ func (m *ApiCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMeta
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Matcher == nil {
-				m.Matcher = &Matcher{}
-			}
-			if err := m.Matcher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3153,8 +9245,46 @@ func (m *ApiCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiId = string(dAtA[iNdEx:postIndex])
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -3177,7 +9307,7 @@ func (m *ApiCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Route) Unmarshal(dAtA []byte) error {
+func (m *Server) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3194,69 +9324,21 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= (uint64(b) & 0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Route: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Route: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= (Status(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				break
 			}
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Server: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Server: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3281,13 +9363,13 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3297,24 +9379,14 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Status |= (Status(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Method = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3339,11 +9411,11 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3368,13 +9440,13 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiId = string(dAtA[iNdEx:postIndex])
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3384,118 +9456,24 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMeta
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = make(map[string]*ValueItem)
-			}
-			var mapkey string
-			var mapvalue *ValueItem
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMeta
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMeta
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthMeta
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMeta
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthMeta
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
-						return ErrInvalidLengthMeta
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ValueItem{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipMeta(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthMeta
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Context[mapkey] = mapvalue
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiConds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCheck", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3519,96 +9497,18 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiConds = append(m.ApiConds, &ApiCondition{})
-			if err := m.ApiConds[len(m.ApiConds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.HealthCheck == nil {
+				m.HealthCheck = &HealthCheck{}
 			}
-			m.Files = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMeta(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.HealthCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthMeta
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Validator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMeta
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Matcher", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
 			}
-			var msglen int
+			m.MaxQPS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3618,30 +9518,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MaxQPS |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Matcher == nil {
-				m.Matcher = &Matcher{}
-			}
-			if err := m.Matcher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMsg", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
 			}
-			var stringLen uint64
+			m.Protocol = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3651,26 +9537,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Protocol |= (ServerProtocol(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ErrorMsg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3680,11 +9556,25 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Client == nil {
+				m.Client = &UpstreamClient{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -3707,7 +9597,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HeaderItem) Unmarshal(dAtA []byte) error {
+func (m *Gateway) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3730,15 +9620,15 @@ func (m *HeaderItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HeaderItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: Gateway: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HeaderItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Gateway: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3763,11 +9653,11 @@ func (m *HeaderItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3792,7 +9682,7 @@ func (m *HeaderItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3816,7 +9706,7 @@ func (m *HeaderItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApiHeaders) Unmarshal(dAtA []byte) error {
+func (m *Host) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3839,17 +9729,17 @@ func (m *ApiHeaders) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApiHeaders: wiretype end group for non-group")
+			return fmt.Errorf("proto: Host: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApiHeaders: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Host: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToBackend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3859,28 +9749,45 @@ func (m *ApiHeaders) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMeta
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToBackend = append(m.ToBackend, &HeaderItem{})
-			if err := m.ToBackend[len(m.ToBackend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= (HostKind(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToClient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -3890,77 +9797,24 @@ func (m *ApiHeaders) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMeta
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToClient = append(m.ToClient, &HeaderItem{})
-			if err := m.ToClient[len(m.ToClient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMeta(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMeta
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CookieItem) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMeta
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CookieItem: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CookieItem: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3985,11 +9839,11 @@ func (m *CookieItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4014,13 +9868,13 @@ func (m *CookieItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.ApiId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expire", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SvrId", wireType)
 			}
-			m.Expire = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -4030,65 +9884,24 @@ func (m *CookieItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Expire |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMeta(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMeta
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApiCookies) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMeta
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApiCookies: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApiCookies: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SvrId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToBackend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorPages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4112,14 +9925,16 @@ func (m *ApiCookies) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToBackend = append(m.ToBackend, &CookieItem{})
-			if err := m.ToBackend[len(m.ToBackend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ErrorPages == nil {
+				m.ErrorPages = &ErrorPages{}
+			}
+			if err := m.ErrorPages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToClient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4143,8 +9958,10 @@ func (m *ApiCookies) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToClient = append(m.ToClient, &CookieItem{})
-			if err := m.ToClient[len(m.ToClient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Cors == nil {
+				m.Cors = &CorsPolicy{}
+			}
+			if err := m.Cors.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4170,7 +9987,7 @@ func (m *ApiCookies) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Api) Unmarshal(dAtA []byte) error {
+func (m *Auth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4193,10 +10010,10 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Api: wiretype end group for non-group")
+			return fmt.Errorf("proto: Auth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Api: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Auth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4230,9 +10047,9 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			m.Status = 0
+			m.Kind = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -4242,14 +10059,14 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (Status(b) & 0x7F) << shift
+				m.Kind |= (AuthKind(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4273,11 +10090,11 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = make(map[string]*ValueItem)
+			if m.Config == nil {
+				m.Config = make(map[string]string)
 			}
 			var mapkey string
-			var mapvalue *ValueItem
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -4323,7 +10140,7 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowMeta
@@ -4333,26 +10150,21 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthMeta
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthMeta
 					}
-					if postmsgIndex > l {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &ValueItem{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipMeta(dAtA[iNdEx:])
@@ -4368,137 +10180,62 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Context[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AuthId = string(dAtA[iNdEx:postIndex])
+			m.Config[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Headers == nil {
-				m.Headers = &ApiHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cookies", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthMeta
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cookies == nil {
-				m.Cookies = &ApiCookies{}
-			}
-			if err := m.Cookies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMeta
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPAcl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPAcl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPAcl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4523,13 +10260,13 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -4539,26 +10276,16 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Status |= (Status(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Method = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var stringLen uint64
+			m.Kind = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -4568,24 +10295,14 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Kind |= (IPAclKind(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lambda", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cidrs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4610,11 +10327,11 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lambda = string(dAtA[iNdEx:postIndex])
+			m.Cidrs = append(m.Cidrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4639,62 +10356,11 @@ func (m *Api) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerId = string(dAtA[iNdEx:postIndex])
+			m.Route = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMeta(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMeta
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Service) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMeta
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Service: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4719,11 +10385,11 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4748,7 +10414,7 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ApiId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4772,7 +10438,7 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
+func (m *ErrorPages) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4795,65 +10461,17 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ErrorPages: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ErrorPages: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMeta
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= (Status(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoadBlance", wireType)
-			}
-			m.LoadBlance = 0
+			m.Format = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -4863,14 +10481,14 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LoadBlance |= (LoadBalance(b) & 0x7F) << shift
+				m.Format |= (ErrorFormat(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bodies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4894,11 +10512,11 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = make(map[string]*ValueItem)
+			if m.Bodies == nil {
+				m.Bodies = make(map[string]string)
 			}
 			var mapkey string
-			var mapvalue *ValueItem
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -4944,7 +10562,7 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowMeta
@@ -4954,26 +10572,21 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthMeta
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthMeta
 					}
-					if postmsgIndex > l {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &ValueItem{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipMeta(dAtA[iNdEx:])
@@ -4989,13 +10602,103 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Context[mapkey] = mapvalue
+			m.Bodies[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disable = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -5005,21 +10708,42 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMeta
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Keys = append(m.Keys, &ValueItem{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleWhileRevalidate", wireType)
+			}
+			m.StaleWhileRevalidate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StaleWhileRevalidate |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -5042,7 +10766,7 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthCheck) Unmarshal(dAtA []byte) error {
+func (m *CachePurge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5065,15 +10789,15 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthCheck: wiretype end group for non-group")
+			return fmt.Errorf("proto: CachePurge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CachePurge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5098,11 +10822,11 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5127,13 +10851,13 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			m.Interval = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -5143,16 +10867,26 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiId", wireType)
 			}
-			m.Timeout = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -5162,11 +10896,21 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -5189,7 +10933,7 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Server) Unmarshal(dAtA []byte) error {
+func (m *CorsPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5212,15 +10956,15 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Server: wiretype end group for non-group")
+			return fmt.Errorf("proto: CorsPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Server: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CorsPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowOrigins", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5245,13 +10989,13 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.AllowOrigins = append(m.AllowOrigins, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMethods", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -5261,14 +11005,24 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (Status(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMethods = append(m.AllowMethods, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowHeaders", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5293,11 +11047,11 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.AllowHeaders = append(m.AllowHeaders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExposeHeaders", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5322,11 +11076,101 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Host = string(dAtA[iNdEx:postIndex])
+			m.ExposeHeaders = append(m.ExposeHeaders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowCredentials", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowCredentials = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			m.MaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAge |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrpcTranscode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrpcTranscode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrpcTranscode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptorName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5351,13 +11195,13 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.DescriptorName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthCheck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -5367,30 +11211,26 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMeta
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HealthCheck == nil {
-				m.HealthCheck = &HealthCheck{}
-			}
-			if err := m.HealthCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
-			m.MaxQPS = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -5400,11 +11240,21 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxQPS |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -5427,7 +11277,7 @@ func (m *Server) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Gateway) Unmarshal(dAtA []byte) error {
+func (m *ProtoDescriptor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5450,17 +11300,75 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Gateway: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProtoDescriptor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gateway: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProtoDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -5470,24 +11378,26 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMeta
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5512,7 +11422,7 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
+			m.File = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5536,7 +11446,7 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Host) Unmarshal(dAtA []byte) error {
+func (m *UpstreamClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5559,15 +11469,15 @@ func (m *Host) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Host: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpstreamClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Host: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpstreamClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5592,13 +11502,13 @@ func (m *Host) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Scheme = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaCert", wireType)
 			}
-			m.Kind = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -5608,14 +11518,24 @@ func (m *Host) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Kind |= (HostKind(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CaCert = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCert", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5640,11 +11560,11 @@ func (m *Host) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ClientCert = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5669,11 +11589,11 @@ func (m *Host) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
+			m.ClientKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5698,13 +11618,13 @@ func (m *Host) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiId = string(dAtA[iNdEx:postIndex])
+			m.ServerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SvrId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -5714,77 +11634,17 @@ func (m *Host) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SvrId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMeta(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthMeta
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Auth) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMeta
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Auth: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Auth: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			m.InsecureSkipVerify = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectTimeout", wireType)
 			}
-			var stringLen uint64
+			m.ConnectTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -5794,26 +11654,16 @@ func (m *Auth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ConnectTimeout |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMeta
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
 			}
-			m.Kind = 0
+			m.ReadTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -5823,16 +11673,16 @@ func (m *Auth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Kind |= (AuthKind(b) & 0x7F) << shift
+				m.ReadTimeout |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteTimeout", wireType)
 			}
-			var msglen int
+			m.WriteTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeta
@@ -5842,110 +11692,49 @@ func (m *Auth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.WriteTimeout |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMeta
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConns", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaxConns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConns |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Config == nil {
-				m.Config = make(map[string]string)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleConnTimeout", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMeta
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.IdleConnTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMeta
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthMeta
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMeta
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthMeta
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipMeta(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthMeta
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleConnTimeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Config[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -6073,84 +11862,153 @@ var (
 	ErrIntOverflowMeta   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("meta/meta.proto", fileDescriptor_meta_a4a970b386fb62e0) }
-
-var fileDescriptor_meta_a4a970b386fb62e0 = []byte{
-	// 1212 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdf, 0x6e, 0xdb, 0xb6,
-	0x17, 0x8e, 0x64, 0x59, 0xb6, 0x8f, 0x9c, 0x84, 0x25, 0x8a, 0x42, 0x28, 0xf0, 0xcb, 0x2f, 0x10,
-	0x56, 0xac, 0x35, 0x36, 0x77, 0x73, 0x6f, 0xb6, 0xde, 0x25, 0x6e, 0x5a, 0xa7, 0x6d, 0xda, 0x94,
-	0x2e, 0x86, 0xde, 0x32, 0x16, 0x5b, 0x69, 0xb6, 0x45, 0x59, 0xa2, 0xdd, 0xf8, 0x45, 0x86, 0xed,
-	0x01, 0xf6, 0x2c, 0xdb, 0xe5, 0x6e, 0x76, 0x5f, 0x64, 0x0f, 0xb1, 0xdb, 0x81, 0x7f, 0xf4, 0xa7,
-	0x59, 0xb2, 0x06, 0x41, 0x6f, 0x6c, 0x1e, 0xf2, 0xe3, 0xe1, 0xc7, 0x73, 0xbe, 0x73, 0x28, 0xd8,
-	0x9e, 0x33, 0x41, 0xef, 0xcb, 0x9f, 0x7e, 0x9a, 0x71, 0xc1, 0xb1, 0x23, 0xc7, 0xc1, 0x53, 0xe8,
-	0xfc, 0x40, 0x67, 0x4b, 0x76, 0x28, 0xd8, 0x1c, 0xdf, 0x03, 0x37, 0xe7, 0xcb, 0x6c, 0xc2, 0x7c,
-	0x6b, 0xd7, 0xba, 0xbb, 0x35, 0xb8, 0xd1, 0x57, 0x78, 0x05, 0x18, 0xab, 0x05, 0x62, 0x00, 0x18,
-	0x83, 0x93, 0xd0, 0x39, 0xf3, 0xed, 0x5d, 0xeb, 0x6e, 0x87, 0xa8, 0x71, 0xf0, 0x06, 0x5a, 0x47,
-	0x54, 0x4c, 0x22, 0x96, 0x61, 0x04, 0x8d, 0x29, 0x5b, 0x2b, 0x37, 0x1d, 0x22, 0x87, 0xf8, 0x0e,
-	0x38, 0xd3, 0x38, 0x09, 0xd5, 0x86, 0xd2, 0xb3, 0x81, 0x3f, 0x8b, 0x93, 0x90, 0xa8, 0x65, 0x7c,
-	0x13, 0x9a, 0x2b, 0x79, 0x9c, 0xdf, 0x50, 0x5b, 0xb5, 0x11, 0x1c, 0x41, 0x77, 0x2f, 0x8d, 0x87,
-	0x3c, 0x09, 0x63, 0x11, 0xf3, 0x04, 0x7f, 0x09, 0xad, 0xb9, 0xde, 0xaa, 0x8e, 0xf0, 0x06, 0x9b,
-	0x1f, 0xf9, 0x23, 0xc5, 0xaa, 0x74, 0x47, 0xd3, 0xf8, 0x30, 0x34, 0x3c, 0xb5, 0x11, 0x7c, 0xb0,
-	0xa1, 0x49, 0xf8, 0x52, 0x30, 0xbc, 0x05, 0x76, 0x1c, 0x1a, 0x9a, 0x76, 0x1c, 0xe2, 0x2f, 0xc0,
-	0xcd, 0x05, 0x15, 0xcb, 0xdc, 0xf0, 0xec, 0x6a, 0xbf, 0x63, 0x35, 0x47, 0xcc, 0x9a, 0xbc, 0x7c,
-	0x4a, 0x45, 0x64, 0x38, 0xaa, 0x31, 0xbe, 0x05, 0xee, 0x9c, 0x89, 0x88, 0x87, 0xbe, 0xa3, 0x66,
-	0x8d, 0x85, 0x7d, 0x68, 0xe5, 0x2c, 0x5b, 0xc5, 0x13, 0xe6, 0x37, 0xd5, 0x42, 0x61, 0x56, 0xdc,
-	0xdc, 0x1a, 0x37, 0x3c, 0x80, 0xd6, 0x84, 0x27, 0x82, 0x9d, 0x0a, 0xbf, 0xb5, 0xdb, 0xb8, 0xeb,
-	0x0d, 0x7c, 0x4d, 0x41, 0xf1, 0xed, 0x0f, 0xf5, 0xd2, 0x41, 0x22, 0xb2, 0x35, 0x29, 0x80, 0xb8,
-	0x0f, 0x6d, 0xaa, 0xc3, 0x93, 0xfb, 0x6d, 0xb5, 0x09, 0xeb, 0x4d, 0xf5, 0xa0, 0x91, 0x12, 0x23,
-	0x4f, 0x7e, 0x1b, 0xcf, 0x58, 0xee, 0x77, 0xf4, 0xc9, 0xca, 0xb8, 0xfd, 0x0c, 0xba, 0x75, 0xf7,
-	0x17, 0xe6, 0xd0, 0x24, 0xc7, 0x56, 0x41, 0xdf, 0xae, 0xc9, 0x43, 0xea, 0xc7, 0x64, 0xeb, 0xa1,
-	0xfd, 0x9d, 0x15, 0x44, 0x4a, 0x57, 0x71, 0x48, 0x05, 0xcf, 0xae, 0x9e, 0xae, 0xdb, 0xd0, 0x66,
-	0x59, 0xc6, 0xb3, 0xa3, 0xfc, 0x9d, 0xc9, 0x58, 0x69, 0xcb, 0x00, 0x9b, 0xd4, 0xc8, 0xb0, 0x37,
-	0x8b, 0x64, 0x04, 0x03, 0x80, 0x11, 0xa3, 0x21, 0xcb, 0x94, 0x84, 0x0b, 0x5d, 0x5a, 0x95, 0x2e,
-	0x8b, 0x8b, 0xd8, 0xe5, 0x45, 0x82, 0x1f, 0x01, 0xf6, 0xd2, 0x58, 0x6f, 0xcb, 0x71, 0x1f, 0x3a,
-	0x82, 0xef, 0xd3, 0xc9, 0x94, 0x25, 0x52, 0x0b, 0x32, 0x7e, 0x48, 0x13, 0xac, 0x1c, 0x93, 0x0a,
-	0x82, 0xbf, 0x82, 0xb6, 0xe0, 0xc3, 0x59, 0xcc, 0x12, 0xe1, 0xdb, 0x97, 0xc0, 0x4b, 0x44, 0xf0,
-	0x14, 0x60, 0xc8, 0xf9, 0x34, 0x66, 0x57, 0xe7, 0x27, 0xef, 0xca, 0x4e, 0xd3, 0x38, 0xd3, 0x65,
-	0xd0, 0x20, 0xc6, 0x32, 0xbc, 0xb5, 0xbb, 0xff, 0xe2, 0x5d, 0x1d, 0x78, 0x25, 0xde, 0x35, 0x78,
-	0xc5, 0xfb, 0xcf, 0x06, 0x34, 0xf6, 0xd2, 0xf8, 0x9a, 0x25, 0xf2, 0x4d, 0x25, 0xe3, 0x86, 0x3a,
-	0xea, 0x56, 0xa9, 0xc8, 0x4b, 0x44, 0x7c, 0x0b, 0x5c, 0xba, 0x14, 0xd1, 0x61, 0x59, 0x40, 0xda,
-	0xc2, 0x3d, 0x68, 0x45, 0x3a, 0x51, 0xaa, 0x80, 0x4a, 0xd2, 0x55, 0x02, 0x49, 0x01, 0x90, 0xd8,
-	0x89, 0x0e, 0x8e, 0x2a, 0xaa, 0x3a, 0xd6, 0x04, 0x8d, 0x14, 0x00, 0x7c, 0x1f, 0x60, 0x55, 0x28,
-	0x34, 0x37, 0xb5, 0x56, 0x29, 0x5a, 0xcf, 0x93, 0x1a, 0xa4, 0xac, 0xfa, 0xf6, 0x85, 0x55, 0xdf,
-	0x39, 0x5f, 0xf5, 0x2b, 0x96, 0xe5, 0x31, 0x4f, 0x7c, 0xd0, 0x55, 0x6f, 0x4c, 0xb9, 0x63, 0x46,
-	0xe7, 0x27, 0x21, 0xf5, 0x3d, 0xbd, 0x43, 0x5b, 0x52, 0xfa, 0xb2, 0x31, 0xb0, 0xec, 0x30, 0xf4,
-	0xbb, 0x5a, 0xfa, 0x85, 0xfd, 0x79, 0x2b, 0xf3, 0x6b, 0x68, 0x8d, 0x4d, 0x07, 0x3a, 0x9f, 0xda,
-	0x8b, 0x9a, 0xfa, 0x2f, 0x36, 0x6c, 0x1a, 0xfc, 0x90, 0x27, 0x6f, 0xe3, 0x77, 0xd7, 0x14, 0xc4,
-	0xb7, 0x00, 0x33, 0x4e, 0xc3, 0xfd, 0x19, 0x4d, 0x26, 0x5a, 0xd6, 0xe5, 0x2b, 0xf0, 0x5c, 0xce,
-	0x53, 0xb5, 0x40, 0x6a, 0x20, 0xfc, 0xb0, 0xd2, 0x90, 0xa3, 0xd2, 0xb3, 0x6b, 0x3c, 0xd7, 0xe9,
-	0x7c, 0x52, 0x4d, 0xcd, 0xba, 0x9a, 0x3e, 0x6f, 0x28, 0xa7, 0xe0, 0x8d, 0x18, 0x9d, 0x89, 0x68,
-	0x18, 0xb1, 0xc9, 0xb4, 0x14, 0x88, 0x55, 0x13, 0x08, 0x06, 0xe7, 0x84, 0x87, 0x45, 0x71, 0xab,
-	0xb1, 0x4c, 0x75, 0x9c, 0x08, 0x96, 0xad, 0xe8, 0xcc, 0xd4, 0x77, 0x69, 0x4b, 0xe1, 0x88, 0x78,
-	0xce, 0xf8, 0x52, 0xa8, 0x32, 0x68, 0x90, 0xc2, 0x0c, 0x7e, 0xb3, 0xc0, 0x1d, 0x2b, 0x45, 0x5c,
-	0xff, 0xd5, 0x52, 0xd9, 0x6d, 0xd4, 0x5a, 0x0f, 0x06, 0x27, 0xe2, 0xb9, 0x30, 0x25, 0xa7, 0xc6,
-	0x72, 0x8e, 0x86, 0x61, 0x66, 0x02, 0xa7, 0xc6, 0xf8, 0x01, 0x78, 0x51, 0x75, 0x53, 0x53, 0x5c,
-	0x37, 0xca, 0xae, 0x57, 0x2c, 0x90, 0x3a, 0x4a, 0x15, 0x07, 0x3d, 0x7d, 0x75, 0x3c, 0xf6, 0x5b,
-	0xba, 0x8b, 0x69, 0x2b, 0xb8, 0x0f, 0xad, 0x27, 0x54, 0xb0, 0xf7, 0x74, 0xfd, 0xaf, 0x9b, 0xc8,
-	0x37, 0x31, 0x0c, 0xb3, 0x5c, 0xf5, 0x27, 0xf9, 0x26, 0x4a, 0x23, 0xf8, 0xc9, 0x02, 0x67, 0x24,
-	0xa9, 0x9d, 0x87, 0x07, 0x1f, 0x7d, 0x54, 0x6c, 0x19, 0x3e, 0x3c, 0x17, 0x9f, 0xfa, 0xa2, 0xa8,
-	0x3f, 0xcb, 0xce, 0x25, 0xcf, 0x72, 0xb3, 0xfe, 0x2c, 0xdf, 0x84, 0x66, 0xbe, 0xca, 0xaa, 0xc7,
-	0x5a, 0x19, 0xc1, 0xaf, 0x16, 0x38, 0x7b, 0x4b, 0x11, 0x5d, 0x8d, 0x98, 0x44, 0xd6, 0x88, 0xf5,
-	0xc1, 0x9d, 0x28, 0x09, 0x9f, 0xeb, 0x90, 0x4b, 0x11, 0xf5, 0xb5, 0xb6, 0xb5, 0xa6, 0x0d, 0xea,
-	0xf6, 0xf7, 0xe0, 0xd5, 0xa6, 0x2f, 0x50, 0xee, 0xcd, 0xba, 0x72, 0x3b, 0x35, 0xa1, 0xf6, 0xfe,
-	0xb6, 0xc0, 0xab, 0x7d, 0xc5, 0xe1, 0x0e, 0x34, 0x1f, 0xc7, 0xa7, 0x2c, 0x44, 0x1b, 0x78, 0x13,
-	0x3a, 0x84, 0x2d, 0x74, 0x27, 0x45, 0x96, 0x31, 0x75, 0xb3, 0x44, 0x36, 0x46, 0xd0, 0x25, 0x6c,
-	0x71, 0x4c, 0x45, 0x74, 0x4c, 0x33, 0x3a, 0x47, 0x0d, 0x7c, 0x03, 0x36, 0x09, 0x5b, 0xbc, 0x5a,
-	0xb2, 0x6c, 0xad, 0xa7, 0x1c, 0xbc, 0x0d, 0x1e, 0x61, 0x8b, 0xc7, 0x3c, 0x9b, 0x3f, 0xa2, 0x82,
-	0xa2, 0x26, 0xde, 0x02, 0x20, 0x2c, 0x4f, 0x8d, 0x53, 0xb7, 0xb0, 0x8d, 0xd7, 0x16, 0xf6, 0xa0,
-	0x45, 0xd8, 0x62, 0xc9, 0x72, 0x81, 0xda, 0x66, 0xf7, 0xd3, 0xf1, 0xcb, 0x17, 0xfb, 0x3c, 0x5c,
-	0x23, 0xd0, 0xe8, 0xc5, 0x9b, 0xa3, 0xe7, 0xca, 0xf6, 0x34, 0x87, 0x3c, 0x2d, 0x11, 0x5d, 0xbd,
-	0x25, 0x4f, 0x0b, 0xc8, 0x26, 0xee, 0x42, 0x5b, 0x4e, 0xf0, 0x24, 0x67, 0x68, 0x0b, 0x03, 0xb8,
-	0xe3, 0x75, 0x2e, 0xd8, 0x1c, 0x6d, 0xf7, 0x46, 0xe0, 0xd5, 0x3e, 0x32, 0xb1, 0x0b, 0xf6, 0xc1,
-	0x2b, 0xb4, 0x21, 0xff, 0x5f, 0x1c, 0x20, 0x4b, 0xfe, 0x3f, 0x7f, 0x8d, 0x6c, 0xf5, 0x7f, 0x80,
-	0x1a, 0xf2, 0xff, 0xc9, 0x6b, 0xe4, 0xa8, 0xff, 0x03, 0xd4, 0x94, 0x81, 0x22, 0xec, 0x1d, 0x3b,
-	0x45, 0x6e, 0xef, 0x7f, 0xe0, 0xea, 0x82, 0xc2, 0x6d, 0x70, 0x5e, 0xa6, 0x2c, 0x41, 0x1b, 0x72,
-	0x79, 0x38, 0xe3, 0x39, 0x43, 0x56, 0xef, 0x1e, 0x78, 0xb5, 0x3e, 0xa6, 0x2e, 0xc1, 0x97, 0x49,
-	0x48, 0xf8, 0x49, 0x2c, 0x91, 0x00, 0xee, 0xe1, 0xf1, 0x88, 0xe6, 0x11, 0xb2, 0x7b, 0xff, 0x87,
-	0x76, 0xa1, 0x51, 0xe9, 0x61, 0x6f, 0x36, 0xe3, 0xef, 0xd1, 0x86, 0x74, 0xfb, 0x88, 0x25, 0x6b,
-	0x64, 0xf5, 0xee, 0x40, 0xbb, 0xd0, 0x8a, 0x4c, 0xc8, 0x48, 0x88, 0x74, 0x9f, 0xe6, 0xf1, 0x44,
-	0xfb, 0x79, 0x29, 0xd7, 0x06, 0xc8, 0xda, 0x47, 0xbf, 0x9f, 0xed, 0x58, 0x7f, 0x9c, 0xed, 0x58,
-	0x1f, 0xce, 0x76, 0xac, 0x9f, 0xff, 0xda, 0xd9, 0x38, 0x71, 0xd5, 0xa7, 0xfd, 0x83, 0x7f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xdd, 0x25, 0xa7, 0x8b, 0xed, 0x0b, 0x00, 0x00,
+func init() { proto.RegisterFile("meta/meta.proto", fileDescriptor_meta_a700e13f33a4e3a1) }
+
+var fileDescriptor_meta_a700e13f33a4e3a1 = []byte{
+	// 2318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
+	0xf1, 0xd7, 0xf0, 0x9b, 0x45, 0x4a, 0x1a, 0xf7, 0xea, 0x6f, 0x0c, 0x8c, 0xfd, 0x3b, 0xc2, 0xc4,
+	0x1b, 0x6b, 0xb9, 0x8e, 0xec, 0x95, 0x83, 0x45, 0xb2, 0x39, 0x51, 0xb4, 0x6c, 0xc9, 0xb6, 0x6c,
+	0xba, 0xa5, 0x78, 0x8d, 0x00, 0x41, 0xd0, 0x9a, 0x69, 0x91, 0x1d, 0x0d, 0x67, 0x46, 0x3d, 0x4d,
+	0x99, 0xcc, 0x31, 0x8f, 0x90, 0x53, 0x5e, 0x20, 0x48, 0x1e, 0x20, 0xe7, 0x9c, 0x73, 0xcc, 0x23,
+	0x24, 0xce, 0x3d, 0x40, 0x4e, 0x39, 0x05, 0x08, 0xfa, 0x63, 0x66, 0x9a, 0xb4, 0x6c, 0x6b, 0x8d,
+	0xcd, 0x85, 0xec, 0xaa, 0xfe, 0xf5, 0x67, 0x55, 0xfd, 0xaa, 0x7a, 0x60, 0x7d, 0x42, 0x05, 0xb9,
+	0x2b, 0x7f, 0xb6, 0x53, 0x9e, 0x88, 0x04, 0xd5, 0x64, 0xdb, 0x7f, 0x0c, 0xed, 0x97, 0x24, 0x9a,
+	0xd2, 0x03, 0x41, 0x27, 0xe8, 0x73, 0x68, 0x64, 0xc9, 0x94, 0x07, 0xd4, 0x73, 0x36, 0x9d, 0xad,
+	0xb5, 0x9d, 0x6b, 0xdb, 0x0a, 0xaf, 0x00, 0x47, 0xaa, 0x03, 0x1b, 0x00, 0x42, 0x50, 0x8b, 0xc9,
+	0x84, 0x7a, 0x95, 0x4d, 0x67, 0xab, 0x8d, 0x55, 0xdb, 0x7f, 0x05, 0xcd, 0x43, 0x22, 0x82, 0x31,
+	0xe5, 0xc8, 0x85, 0xea, 0x19, 0x9d, 0xab, 0x69, 0xda, 0x58, 0x36, 0xd1, 0x67, 0x50, 0x3b, 0x63,
+	0x71, 0xe8, 0x55, 0xec, 0x99, 0x0d, 0xfc, 0x09, 0x8b, 0x43, 0xac, 0xba, 0xd1, 0x06, 0xd4, 0x2f,
+	0xe4, 0x72, 0x5e, 0x55, 0x0d, 0xd5, 0x82, 0x7f, 0x08, 0xdd, 0x7e, 0xca, 0x06, 0x49, 0x1c, 0x32,
+	0xc1, 0x92, 0x18, 0xdd, 0x86, 0xe6, 0x44, 0x0f, 0x55, 0x4b, 0x74, 0x76, 0x56, 0x17, 0xe6, 0xc3,
+	0x79, 0xaf, 0x9c, 0x8e, 0xa4, 0xec, 0x20, 0x34, 0xfb, 0xd4, 0x82, 0xff, 0x53, 0xe8, 0x7c, 0x43,
+	0xd9, 0x68, 0x2c, 0x68, 0xd8, 0x4f, 0x59, 0x09, 0x72, 0x2c, 0x10, 0xba, 0x0e, 0x8d, 0xd7, 0x0a,
+	0xa4, 0xc6, 0xd6, 0xb1, 0x91, 0x7c, 0x0e, 0x8d, 0x43, 0xc6, 0x79, 0xc2, 0x91, 0x07, 0xcd, 0x8c,
+	0xf2, 0x0b, 0x66, 0xee, 0xab, 0x8d, 0x73, 0x11, 0xdd, 0x80, 0x96, 0x6c, 0x52, 0x5e, 0xac, 0x5c,
+	0xc8, 0x72, 0x54, 0x4a, 0x79, 0x40, 0x63, 0xa1, 0xce, 0x58, 0xc7, 0xb9, 0x28, 0x7b, 0x04, 0x9b,
+	0xd0, 0x64, 0x2a, 0xbc, 0xda, 0xa6, 0xb3, 0x55, 0xc5, 0xb9, 0xe8, 0xcf, 0x60, 0xb5, 0x9f, 0xb2,
+	0x97, 0x94, 0x67, 0x2c, 0x89, 0x59, 0x3c, 0x42, 0x5f, 0x2c, 0x59, 0xea, 0x13, 0x63, 0x29, 0x8d,
+	0xf8, 0xb0, 0xad, 0xd0, 0x0f, 0x60, 0x2d, 0xa4, 0xa7, 0x64, 0x1a, 0x09, 0x33, 0xc6, 0x5c, 0xf8,
+	0x92, 0xd6, 0x1f, 0xc2, 0x5a, 0x3f, 0x65, 0x0f, 0x68, 0xca, 0x69, 0x40, 0xd4, 0xdd, 0x23, 0xa8,
+	0x85, 0x44, 0xe4, 0x47, 0x56, 0x6d, 0x79, 0x57, 0xd9, 0x34, 0xce, 0xa8, 0x30, 0x6b, 0x18, 0x49,
+	0x62, 0x23, 0x16, 0x9f, 0x99, 0xb9, 0x55, 0xdb, 0xff, 0x25, 0x74, 0x8f, 0x39, 0x39, 0x3d, 0x65,
+	0xc1, 0x51, 0x1a, 0x31, 0x21, 0x1d, 0x83, 0xa4, 0x2c, 0xf3, 0x9c, 0xcd, 0xea, 0x56, 0x27, 0x77,
+	0x0c, 0xcb, 0x3c, 0x58, 0x75, 0xcb, 0x25, 0x82, 0x24, 0x39, 0x63, 0xf9, 0x31, 0x8c, 0x94, 0x7b,
+	0x5a, 0xb5, 0xf0, 0x34, 0xff, 0x3f, 0x55, 0xa8, 0xe3, 0x64, 0x2a, 0x28, 0x5a, 0x83, 0x0a, 0xcb,
+	0xad, 0x5a, 0x61, 0x21, 0xba, 0x05, 0x8d, 0x4c, 0x10, 0x31, 0xcd, 0x8c, 0x17, 0x76, 0xf5, 0x62,
+	0x47, 0x4a, 0x87, 0x4d, 0x9f, 0xdc, 0x74, 0x4a, 0xc4, 0x38, 0xdf, 0xb4, 0x6c, 0xcb, 0xd5, 0x27,
+	0x54, 0x8c, 0x93, 0x50, 0x59, 0xa6, 0x8d, 0x8d, 0x64, 0xbb, 0x40, 0x7d, 0xd1, 0x05, 0x0a, 0xa7,
+	0x6a, 0xd8, 0x4e, 0xb5, 0x03, 0xcd, 0x20, 0x89, 0x05, 0x9d, 0x09, 0xaf, 0xa9, 0xce, 0xeb, 0xe9,
+	0x2d, 0xa8, 0xfd, 0x6e, 0x0f, 0x74, 0xd7, 0x5e, 0x2c, 0xf8, 0x1c, 0xe7, 0x40, 0xb4, 0x0d, 0x2d,
+	0xa2, 0x9d, 0x3f, 0xf3, 0x5a, 0x6a, 0x10, 0xd2, 0x83, 0xec, 0x90, 0xc0, 0x05, 0x46, 0xae, 0x7c,
+	0xca, 0x22, 0x9a, 0x79, 0x6d, 0xbd, 0xb2, 0x12, 0xe4, 0x09, 0xc6, 0x49, 0x26, 0x0e, 0x42, 0x0f,
+	0xf4, 0x09, 0xb4, 0x84, 0xb6, 0xa0, 0x9e, 0x49, 0x3b, 0x78, 0x9d, 0x4d, 0xa7, 0x9c, 0xda, 0xb6,
+	0x10, 0xd6, 0x00, 0x79, 0x7b, 0x13, 0xe5, 0xf8, 0x5e, 0x57, 0x41, 0xcd, 0xed, 0xe9, 0x60, 0xc0,
+	0xa6, 0x0f, 0xdd, 0x07, 0xb8, 0x28, 0xfc, 0xd4, 0x5b, 0x55, 0xc8, 0x4f, 0x8a, 0xfd, 0x96, 0x2e,
+	0x8c, 0x2d, 0xd8, 0x8d, 0x27, 0xd0, 0xb5, 0xcf, 0x7e, 0x29, 0x7d, 0x18, 0x5e, 0xa8, 0xa8, 0x19,
+	0xd7, 0x2d, 0x66, 0x92, 0xd4, 0x65, 0x88, 0xe2, 0xeb, 0xca, 0x8f, 0x1d, 0x7f, 0xac, 0x28, 0x8d,
+	0x85, 0x44, 0x24, 0xfc, 0xea, 0x4c, 0x71, 0x03, 0x5a, 0x54, 0x1e, 0xe0, 0x30, 0x1b, 0xe5, 0x21,
+	0x9b, 0xcb, 0xca, 0xbd, 0xb5, 0xdf, 0xe8, 0x88, 0x35, 0x92, 0xbf, 0x03, 0xb0, 0x4f, 0x49, 0x48,
+	0xb9, 0x62, 0xcf, 0x3c, 0xcc, 0x1c, 0x2b, 0xcc, 0xcc, 0x41, 0x2a, 0xa5, 0x77, 0xfe, 0x0a, 0xa0,
+	0x9f, 0x32, 0x3d, 0x2c, 0x43, 0xdb, 0xd0, 0x16, 0xc9, 0x2e, 0x09, 0xce, 0x68, 0x1c, 0x9a, 0x08,
+	0x70, 0xf5, 0x06, 0xcb, 0x89, 0x71, 0x09, 0x41, 0x77, 0xa0, 0x25, 0x92, 0x41, 0xc4, 0x24, 0x7b,
+	0x54, 0xde, 0x01, 0x2f, 0x10, 0xfe, 0x63, 0x80, 0x81, 0x8a, 0x92, 0xab, 0xef, 0x4f, 0x9e, 0x95,
+	0xce, 0x52, 0xc6, 0x35, 0x03, 0x57, 0xb1, 0x91, 0xcc, 0xbe, 0xf5, 0x74, 0xef, 0xdb, 0x77, 0xb9,
+	0xe0, 0x95, 0xf6, 0x6d, 0xc1, 0xcb, 0x7d, 0xff, 0xbd, 0x01, 0x55, 0x49, 0xcc, 0x1f, 0x17, 0xbf,
+	0xf7, 0xca, 0x18, 0xab, 0xaa, 0xa5, 0xae, 0x17, 0xee, 0xf7, 0x8e, 0x08, 0xbb, 0x0e, 0x0d, 0x32,
+	0x15, 0xe3, 0x83, 0x22, 0xba, 0xb5, 0x84, 0x7a, 0xd0, 0x1c, 0x6b, 0x43, 0xa9, 0xe8, 0x2e, 0x36,
+	0x5d, 0x1a, 0x10, 0xe7, 0x00, 0x89, 0xd5, 0x8c, 0x94, 0x79, 0x8d, 0x25, 0xac, 0xb9, 0x34, 0x9c,
+	0x03, 0xd0, 0x5d, 0x80, 0x8b, 0xdc, 0x43, 0x33, 0x43, 0x04, 0xa5, 0x47, 0x6b, 0x3d, 0xb6, 0x20,
+	0x05, 0x25, 0xb5, 0x2e, 0xa5, 0xa4, 0xf6, 0x32, 0x25, 0x99, 0xc8, 0x32, 0x91, 0x9e, 0x8b, 0x72,
+	0x44, 0x44, 0x26, 0x27, 0x21, 0x51, 0xb1, 0xde, 0xc6, 0x46, 0x5a, 0xc8, 0x56, 0xdd, 0xa5, 0x6c,
+	0x75, 0x0f, 0x40, 0x85, 0xc1, 0x90, 0x8c, 0x68, 0xe6, 0xad, 0xda, 0x27, 0xdb, 0x2b, 0xf4, 0xd8,
+	0xc2, 0xa0, 0xdb, 0x50, 0x0f, 0x48, 0x30, 0xa6, 0xde, 0xda, 0xa6, 0x53, 0x12, 0xfa, 0x40, 0xaa,
+	0x06, 0x49, 0x7c, 0xca, 0x46, 0x58, 0xf7, 0xa3, 0x6d, 0x40, 0x21, 0xcb, 0xc8, 0x49, 0x44, 0x07,
+	0xc9, 0x24, 0xe5, 0x34, 0x53, 0x7b, 0x5e, 0xdf, 0x74, 0xb6, 0x5a, 0xf8, 0x92, 0x1e, 0x74, 0x0b,
+	0x6a, 0x81, 0xbc, 0x2f, 0xd7, 0xde, 0xc4, 0x20, 0xe1, 0xd9, 0x30, 0x89, 0x58, 0x30, 0xc7, 0xaa,
+	0x57, 0xc7, 0x2a, 0xa7, 0x64, 0xe2, 0x5d, 0x53, 0x33, 0x19, 0x09, 0x6d, 0x42, 0x67, 0x42, 0x66,
+	0xbb, 0x49, 0x38, 0x3f, 0x62, 0xbf, 0xa6, 0x1e, 0x52, 0xce, 0x6d, 0xab, 0xd0, 0x6d, 0xa8, 0x8d,
+	0x78, 0x1a, 0x78, 0x9f, 0xd8, 0x9c, 0xf5, 0x88, 0xa7, 0xc1, 0x31, 0x27, 0x71, 0x16, 0x24, 0x21,
+	0xc5, 0x0a, 0x60, 0xe7, 0xe9, 0x8d, 0x85, 0x3c, 0x6d, 0x51, 0xe4, 0xff, 0xbd, 0x87, 0x22, 0xbf,
+	0x82, 0x4e, 0x58, 0x26, 0x54, 0xef, 0xba, 0x82, 0x6e, 0x14, 0xee, 0x62, 0x25, 0x5b, 0x6c, 0x03,
+	0xbf, 0x5b, 0x96, 0xfc, 0x21, 0x34, 0x8f, 0x4c, 0xaa, 0x5a, 0x0e, 0xb3, 0xcb, 0x6a, 0xbb, 0x7f,
+	0x55, 0x61, 0xd5, 0xe0, 0xb5, 0x15, 0x3f, 0x32, 0x38, 0xbf, 0x04, 0x88, 0x12, 0x12, 0xee, 0x46,
+	0x24, 0x0e, 0x34, 0xc5, 0x14, 0xc5, 0xe0, 0x53, 0xa9, 0x27, 0xaa, 0x03, 0x5b, 0x20, 0xf4, 0x75,
+	0x19, 0xcf, 0x35, 0x15, 0x2a, 0x9b, 0x66, 0x66, 0x7b, 0x3b, 0x1f, 0x8c, 0xec, 0xfa, 0x42, 0x64,
+	0x7f, 0x05, 0xdd, 0x94, 0x27, 0xb3, 0xb9, 0x09, 0x63, 0xaf, 0x61, 0x27, 0xbf, 0xa1, 0xd5, 0x83,
+	0x17, 0x70, 0x4b, 0xe1, 0xd0, 0xbc, 0x42, 0x38, 0xe4, 0x5e, 0xdb, 0x7a, 0xaf, 0xd7, 0xde, 0x81,
+	0x46, 0xa0, 0xd9, 0xb1, 0x6d, 0x7b, 0xc3, 0xcf, 0x52, 0xed, 0xbd, 0x9a, 0x17, 0xb1, 0xc1, 0xd8,
+	0x0e, 0x08, 0x0b, 0x0e, 0xf8, 0xdd, 0xba, 0xc8, 0x9f, 0x1c, 0xe8, 0xda, 0x77, 0x81, 0xee, 0xc0,
+	0x35, 0x13, 0x97, 0xaf, 0x1e, 0x26, 0xfc, 0x35, 0xe1, 0x21, 0xd5, 0x1e, 0xd0, 0xc2, 0x6f, 0x77,
+	0xa8, 0x12, 0xd3, 0x28, 0x31, 0x25, 0xd1, 0x41, 0xaa, 0x96, 0x6c, 0xe1, 0x25, 0x2d, 0xba, 0x09,
+	0x60, 0x34, 0x2f, 0x19, 0x51, 0x2e, 0xd1, 0xc2, 0x96, 0x06, 0x7d, 0x0a, 0xed, 0xd3, 0x62, 0xb5,
+	0x9a, 0xea, 0x2e, 0x15, 0xf2, 0x84, 0x17, 0x8c, 0x18, 0xf3, 0xca, 0xa6, 0x7f, 0x06, 0x9d, 0x7d,
+	0x4a, 0x22, 0x31, 0x1e, 0x8c, 0x69, 0x70, 0x56, 0x70, 0xa7, 0x63, 0x71, 0x27, 0x82, 0xda, 0x49,
+	0x12, 0xe6, 0x79, 0x4f, 0xb5, 0x25, 0x0b, 0xb2, 0x58, 0x50, 0x7e, 0x41, 0x22, 0x93, 0xfa, 0x0a,
+	0xf9, 0x3d, 0x95, 0xf9, 0x1f, 0x2a, 0xd0, 0x38, 0x52, 0x64, 0xf9, 0xf1, 0xd5, 0xa6, 0x0a, 0xb6,
+	0xaa, 0x95, 0x95, 0x11, 0xd4, 0x64, 0x75, 0x66, 0xb2, 0x91, 0x6a, 0x4b, 0x1d, 0x09, 0x43, 0x6e,
+	0x0e, 0xaa, 0xda, 0xe8, 0x3e, 0x74, 0xc6, 0xe5, 0x49, 0x8d, 0x13, 0x5f, 0x2b, 0x0a, 0x82, 0xbc,
+	0x03, 0xdb, 0x28, 0x95, 0x37, 0xc8, 0xec, 0xc5, 0xf0, 0x48, 0xb9, 0x6f, 0x15, 0x1b, 0x09, 0xdd,
+	0x83, 0x96, 0x7a, 0x18, 0x06, 0x49, 0xa4, 0x9c, 0x75, 0x2d, 0x77, 0x42, 0x7d, 0xbc, 0xa1, 0xe9,
+	0xc3, 0x05, 0xea, 0xdb, 0x39, 0xad, 0x7f, 0x17, 0x9a, 0x8f, 0x88, 0xa0, 0xaf, 0xc9, 0xfc, 0xad,
+	0x9b, 0x92, 0xb5, 0x72, 0x18, 0xf2, 0x4c, 0x95, 0x06, 0x6d, 0xac, 0x05, 0xff, 0x9f, 0x0e, 0xd4,
+	0xf6, 0xe5, 0xd1, 0x97, 0xe1, 0xfe, 0xc2, 0x53, 0x72, 0xcd, 0x9c, 0x37, 0xc9, 0xc4, 0x87, 0xde,
+	0x91, 0x76, 0xb9, 0x5e, 0x7b, 0x47, 0xb9, 0x5e, 0xb7, 0xcb, 0xf5, 0x0d, 0xa8, 0x67, 0x17, 0xbc,
+	0x2c, 0xe2, 0x95, 0xf0, 0xbf, 0x22, 0x01, 0xff, 0xf7, 0x0e, 0xd4, 0xfa, 0x53, 0x31, 0xbe, 0xda,
+	0x81, 0x25, 0xd2, 0x3a, 0xf0, 0xb6, 0x7c, 0x1f, 0x49, 0x26, 0x5c, 0x2a, 0x7a, 0xa6, 0x62, 0xbc,
+	0xad, 0x29, 0x52, 0x53, 0xa3, 0x41, 0xdd, 0xf8, 0x09, 0x74, 0x2c, 0xf5, 0x25, 0x44, 0xb1, 0x61,
+	0x13, 0x45, 0xdb, 0xe6, 0x85, 0x3f, 0x3b, 0x50, 0x3f, 0x18, 0xf6, 0x83, 0xe8, 0x23, 0x5d, 0xfe,
+	0xfb, 0xe6, 0x38, 0x9a, 0xfd, 0x0d, 0x03, 0xa9, 0x09, 0x17, 0x0d, 0x18, 0x30, 0xe9, 0x13, 0x35,
+	0xed, 0x13, 0x4a, 0x90, 0x5a, 0x2e, 0x9f, 0x4a, 0xb9, 0x99, 0x94, 0x60, 0x9b, 0xb5, 0xf1, 0x0e,
+	0xb3, 0x36, 0xed, 0xf7, 0xff, 0x1f, 0x1d, 0x80, 0xd2, 0x52, 0xf2, 0xb3, 0xc7, 0x69, 0xc2, 0x27,
+	0x44, 0x2c, 0x7e, 0xf6, 0x50, 0x88, 0x87, 0xaa, 0x03, 0x1b, 0x00, 0xfa, 0x11, 0x34, 0x4e, 0x92,
+	0x90, 0x51, 0xed, 0xaa, 0x9d, 0x9d, 0x4f, 0x97, 0xcd, 0xbe, 0xbd, 0xab, 0xba, 0xcd, 0x5d, 0x6b,
+	0xac, 0xbc, 0x6b, 0x4b, 0xfd, 0xad, 0xee, 0xfa, 0xb7, 0x0e, 0x74, 0xac, 0xda, 0x49, 0x1e, 0xd5,
+	0x50, 0xa3, 0x21, 0xde, 0x5c, 0x94, 0xb3, 0x0a, 0x11, 0xa9, 0x19, 0xaa, 0x58, 0x36, 0xd5, 0x3d,
+	0xd3, 0x79, 0x66, 0x1c, 0xe2, 0x2d, 0xa6, 0x57, 0x9d, 0x68, 0x07, 0x36, 0x32, 0x41, 0x22, 0xfa,
+	0xcd, 0x98, 0x45, 0x14, 0x53, 0x53, 0x74, 0x52, 0xc3, 0x73, 0x97, 0xf6, 0xf9, 0x3f, 0x07, 0x50,
+	0x7b, 0x1a, 0x4e, 0xf9, 0xe8, 0xd2, 0xf2, 0x41, 0xb1, 0x57, 0x65, 0x91, 0xbd, 0xde, 0x7a, 0x53,
+	0x17, 0xb6, 0xa9, 0xd9, 0xb6, 0x79, 0xe3, 0x00, 0x94, 0x91, 0x81, 0x7c, 0xe8, 0x92, 0x28, 0x4a,
+	0x5e, 0x3f, 0xe7, 0x6c, 0xc4, 0x62, 0xfd, 0x95, 0xa0, 0x8d, 0x17, 0x74, 0x05, 0xe6, 0x50, 0x15,
+	0xc0, 0x39, 0x8b, 0x2c, 0xe8, 0x0a, 0x4c, 0x9e, 0xf0, 0xab, 0x16, 0xc6, 0xe8, 0xd0, 0x2d, 0x58,
+	0xa5, 0xb3, 0x34, 0xc9, 0x68, 0x0e, 0xd2, 0xae, 0xb7, 0xa8, 0x44, 0x3d, 0x70, 0xd5, 0xa8, 0x01,
+	0xa7, 0x21, 0x8d, 0x05, 0x23, 0x91, 0x7e, 0x1d, 0xb4, 0xf0, 0x5b, 0x7a, 0xc3, 0xb5, 0xfd, 0x91,
+	0xf6, 0x4b, 0xcd, 0xb5, 0xfd, 0x11, 0xf5, 0x03, 0x58, 0x5d, 0x28, 0x2c, 0xf5, 0xe7, 0x98, 0x2c,
+	0xe0, 0x2c, 0x15, 0x09, 0x7f, 0x56, 0xbe, 0xd2, 0x96, 0xb4, 0x56, 0xd1, 0x5f, 0x59, 0x28, 0xfa,
+	0xf3, 0x84, 0x56, 0x2d, 0x13, 0x9a, 0xff, 0x0b, 0x58, 0x57, 0xa4, 0xfd, 0xa0, 0x98, 0xe2, 0x2a,
+	0x95, 0x9e, 0xf9, 0xbe, 0xa3, 0x13, 0x71, 0x57, 0x7d, 0xdf, 0x21, 0x52, 0x27, 0xbf, 0x22, 0xe4,
+	0x09, 0x49, 0xb6, 0xfd, 0xdf, 0x54, 0x61, 0x6d, 0x91, 0xea, 0x55, 0xed, 0x1d, 0x8c, 0x69, 0xb1,
+	0x7b, 0x23, 0x49, 0x7d, 0x40, 0x06, 0x94, 0x17, 0x9f, 0x87, 0xb4, 0x24, 0x33, 0xbf, 0x4e, 0x0e,
+	0xaa, 0x4f, 0xef, 0xdd, 0xd2, 0xc8, 0xcc, 0xaf, 0xa5, 0x27, 0x74, 0x6e, 0xd6, 0x2e, 0x15, 0x72,
+	0xb4, 0x7e, 0xa6, 0xa8, 0xfb, 0xd2, 0x84, 0x60, 0x69, 0xe4, 0xfb, 0x82, 0xc5, 0x19, 0x0d, 0xa6,
+	0x9c, 0x1e, 0x9d, 0xb1, 0xf4, 0x25, 0xe5, 0xec, 0x74, 0xae, 0x0c, 0xd1, 0xc2, 0x97, 0xf4, 0x48,
+	0x1b, 0x04, 0x49, 0x1c, 0xd3, 0x40, 0x1c, 0x9b, 0x5c, 0xaf, 0x13, 0xe4, 0x92, 0x56, 0xbe, 0x24,
+	0x38, 0x25, 0x61, 0x0e, 0x6a, 0xe9, 0x97, 0x84, 0xa5, 0x92, 0xce, 0xf6, 0x9a, 0x33, 0x41, 0x73,
+	0x48, 0x5b, 0x41, 0x16, 0x74, 0xb2, 0xdc, 0x98, 0x90, 0xd9, 0x20, 0x89, 0xe3, 0xcc, 0x14, 0x71,
+	0x85, 0x8c, 0xb6, 0x60, 0x9d, 0x85, 0xf2, 0xf1, 0x13, 0xc7, 0xf9, 0x14, 0x1d, 0x05, 0x59, 0x56,
+	0xf7, 0xfe, 0xed, 0x40, 0xc7, 0xfa, 0x3c, 0x8b, 0xda, 0x50, 0x7f, 0xc8, 0x66, 0x34, 0x74, 0x57,
+	0xd0, 0x2a, 0xb4, 0x31, 0x3d, 0xd7, 0x5e, 0xeb, 0x3a, 0x46, 0xd4, 0x4f, 0x51, 0xb7, 0x82, 0x5c,
+	0xe8, 0x62, 0x7a, 0x3e, 0x24, 0x62, 0x3c, 0x24, 0x9c, 0x4c, 0xdc, 0x2a, 0xba, 0x06, 0xab, 0x98,
+	0x9e, 0xbf, 0x98, 0x52, 0x3e, 0xd7, 0xaa, 0x1a, 0x5a, 0x87, 0x0e, 0xa6, 0xe7, 0x92, 0x02, 0x1f,
+	0x10, 0x41, 0xdc, 0x3a, 0x5a, 0x03, 0xc0, 0x34, 0x4b, 0xcd, 0xa4, 0x8d, 0x5c, 0x36, 0xb3, 0x36,
+	0x51, 0x07, 0x9a, 0x98, 0x9e, 0x4f, 0x69, 0x26, 0xdc, 0x96, 0x19, 0xfd, 0xf8, 0xe8, 0xf9, 0x33,
+	0xf9, 0xc4, 0x72, 0x41, 0xa3, 0xcf, 0x5f, 0x1d, 0x3e, 0x55, 0x72, 0x47, 0xef, 0x21, 0x4b, 0x0b,
+	0x44, 0x57, 0x0f, 0xc9, 0xd2, 0x1c, 0xb2, 0x8a, 0xba, 0xd0, 0x92, 0x8a, 0x24, 0xce, 0xa8, 0xbb,
+	0x86, 0x00, 0x1a, 0x47, 0xf3, 0x4c, 0xd0, 0x89, 0xbb, 0xde, 0xdb, 0x87, 0x8e, 0xf5, 0xf5, 0x18,
+	0x35, 0xa0, 0xb2, 0xf7, 0xc2, 0x5d, 0x91, 0xff, 0xcf, 0xf6, 0x5c, 0x47, 0xfe, 0x3f, 0x3d, 0x76,
+	0x2b, 0xea, 0x7f, 0xcf, 0xad, 0xca, 0xff, 0x47, 0xc7, 0x6e, 0x4d, 0xfd, 0xef, 0xb9, 0x75, 0x79,
+	0x51, 0x98, 0x8e, 0xe8, 0xcc, 0x6d, 0xf4, 0x1e, 0xc2, 0xea, 0xc2, 0x77, 0x53, 0xb9, 0x0b, 0xa3,
+	0x90, 0xf7, 0xe3, 0xae, 0xc8, 0xab, 0x31, 0x8a, 0x7e, 0x10, 0xd0, 0x54, 0xb8, 0x8e, 0xa5, 0x32,
+	0x97, 0x51, 0xe9, 0xfd, 0x3f, 0x34, 0x74, 0xa2, 0x43, 0x2d, 0xa8, 0x3d, 0x4f, 0x69, 0xec, 0xae,
+	0xc8, 0x65, 0x06, 0x51, 0x92, 0x51, 0xd7, 0xe9, 0x7d, 0x0e, 0x1d, 0xeb, 0x85, 0xa3, 0x2e, 0x23,
+	0x99, 0xc6, 0x21, 0x4e, 0x4e, 0x98, 0x44, 0x02, 0x34, 0x0e, 0x86, 0xfb, 0x24, 0x1b, 0xbb, 0x95,
+	0xde, 0x1d, 0x58, 0x5b, 0x2c, 0xba, 0xe4, 0x3c, 0xfb, 0xc7, 0xc7, 0xc3, 0x2f, 0xdd, 0x15, 0xd4,
+	0x84, 0xea, 0xfe, 0xce, 0x40, 0x1f, 0x71, 0x7f, 0xc7, 0xad, 0xf4, 0xbe, 0x07, 0xad, 0xbc, 0xf8,
+	0x91, 0xb8, 0xbe, 0x24, 0x21, 0x77, 0x45, 0x6e, 0xe2, 0x01, 0x8d, 0xe7, 0xae, 0xd3, 0xfb, 0x0c,
+	0x5a, 0x79, 0xb1, 0x20, 0xdd, 0x60, 0x5f, 0x88, 0x74, 0x97, 0x64, 0x2c, 0xd0, 0xab, 0x3e, 0x97,
+	0x7d, 0x3b, 0xae, 0xd3, 0xbb, 0x05, 0xed, 0x22, 0x09, 0x4b, 0x4b, 0x1e, 0x0c, 0xf3, 0xa9, 0xd4,
+	0xde, 0xcc, 0x64, 0x5f, 0x40, 0xc7, 0x4a, 0x8c, 0x72, 0x95, 0x63, 0x3a, 0x13, 0x7a, 0x3d, 0x69,
+	0x49, 0xd7, 0x91, 0xad, 0xfd, 0xe3, 0xc3, 0xa7, 0x6e, 0x65, 0xd7, 0xfd, 0xcb, 0x9b, 0x9b, 0xce,
+	0x5f, 0xdf, 0xdc, 0x74, 0xfe, 0xf6, 0xe6, 0xa6, 0xf3, 0xbb, 0x7f, 0xdc, 0x5c, 0x39, 0x69, 0xa8,
+	0xea, 0xf1, 0xfe, 0x7f, 0x07, 0x00, 0xdd, 0xc0, 0x41, 0xfe, 0x8f, 0x18, 0x00, 0x00,
 }
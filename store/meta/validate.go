@@ -1,6 +1,13 @@
 package meta
 
-import "errors"
+import (
+	"errors"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
 
 func (h *Host) Valid() error {
 	if h.Id == "" {
@@ -12,7 +19,28 @@ func (h *Host) Valid() error {
 	if h.Value == "" {
 		return errors.New("host value should not be empty")
 	}
-	return nil
+	if strings.HasPrefix(h.Value, "~") {
+		if _, err := regexp.Compile(h.Value[1:]); err != nil {
+			return errors.New("invalid host regexp, " + err.Error())
+		}
+	} else if strings.HasPrefix(h.Value, "*.") {
+		name := h.Value[2:]
+		if idx := strings.LastIndexByte(name, ':'); idx >= 0 {
+			if _, err := strconv.ParseUint(name[idx+1:], 10, 16); err != nil {
+				return errors.New("invalid host port " + name[idx+1:])
+			}
+			name = name[:idx]
+		}
+		if len(name) <= 0 || strings.ContainsAny(name, "*:") {
+			return errors.New("invalid wildcard host " + h.Value)
+		}
+	} else if h.Value != "*" && strings.Contains(h.Value, "*") {
+		return errors.New("invalid wildcard host " + h.Value)
+	}
+	if err := h.Cors.Valid(); err != nil {
+		return err
+	}
+	return h.ErrorPages.Valid()
 }
 
 func (a *Auth) Valid() error {
@@ -32,7 +60,13 @@ func (r *Route) Valid() error {
 	if _, ok := Status_name[int32(r.Status)]; !ok {
 		return errors.New("invalid route status value")
 	}
-	return nil
+	if err := r.Split.Valid(); err != nil {
+		return err
+	}
+	if err := r.Mirror.Valid(); err != nil {
+		return err
+	}
+	return r.Versioning.Valid()
 }
 
 func (s *Service) Valid() error {
@@ -52,7 +86,28 @@ func (a *Api) Valid() error {
 	if _, ok := Status_name[int32(a.Status)]; !ok {
 		return errors.New("invalid api status value")
 	}
-	return nil
+	if a.MaxBodySize < 0 {
+		return errors.New("api max body size should not be negative")
+	}
+	if a.Timeout < 0 {
+		return errors.New("api timeout should not be negative")
+	}
+	if err := a.Cache.Valid(); err != nil {
+		return err
+	}
+	if err := a.Cors.Valid(); err != nil {
+		return err
+	}
+	if err := a.Grpc.Valid(); err != nil {
+		return err
+	}
+	if err := a.Mirror.Valid(); err != nil {
+		return err
+	}
+	if err := a.Deprecation.Valid(); err != nil {
+		return err
+	}
+	return a.ErrorPages.Valid()
 }
 
 func (s *Server) Valid() error {
@@ -65,7 +120,10 @@ func (s *Server) Valid() error {
 	if _, ok := Status_name[int32(s.Status)]; !ok {
 		return errors.New("invalid server status value")
 	}
-	return nil
+	if _, ok := ServerProtocol_name[int32(s.Protocol)]; !ok {
+		return errors.New("invalid server protocol value")
+	}
+	return s.Client.Valid()
 }
 
 func (c *ServiceConfig) Valid() error {
@@ -78,7 +136,16 @@ func (c *ServiceConfig) Valid() error {
 	if _, ok := Status_name[int32(c.Status)]; !ok {
 		return errors.New("invalid service status value")
 	}
-	return nil
+	if c.Timeout < 0 {
+		return errors.New("service timeout should not be negative")
+	}
+	if err := c.Cors.Valid(); err != nil {
+		return err
+	}
+	if err := c.Client.Valid(); err != nil {
+		return err
+	}
+	return c.ErrorPages.Valid()
 }
 
 func (g *Gateway) Valid() error {
@@ -90,3 +157,209 @@ func (g *Gateway) Valid() error {
 	}
 	return nil
 }
+
+func (a *IPAcl) Valid() error {
+	if a.Id == "" {
+		return errors.New("ip acl id should not be empty")
+	}
+	if _, ok := IPAclKind_name[int32(a.Kind)]; !ok {
+		return errors.New("invalid ip acl kind value")
+	}
+	if _, ok := Status_name[int32(a.Status)]; !ok {
+		return errors.New("invalid ip acl status value")
+	}
+	if len(a.ApiId) > 0 && len(a.Service) <= 0 {
+		return errors.New("ip acl service should not be empty when apiId is set")
+	}
+	for _, cidr := range a.Cidrs {
+		if strings.IndexByte(cidr, '/') >= 0 {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return errors.New("invalid ip acl cidr " + cidr)
+			}
+		} else if net.ParseIP(cidr) == nil {
+			return errors.New("invalid ip acl address " + cidr)
+		}
+	}
+	return nil
+}
+
+// Valid 错误页的key可以是状态码(404)、状态码分类(4xx)或者*
+func (e *ErrorPages) Valid() error {
+	if e == nil {
+		return nil
+	}
+	if _, ok := ErrorFormat_name[int32(e.Format)]; !ok {
+		return errors.New("invalid error pages format value")
+	}
+	for key := range e.Bodies {
+		if key == "*" {
+			continue
+		}
+		if len(key) != 3 || key[0] < '1' || key[0] > '5' {
+			return errors.New("invalid error pages key " + key)
+		}
+		if key[1:] == "xx" {
+			continue
+		}
+		if _, err := strconv.Atoi(key); err != nil {
+			return errors.New("invalid error pages key " + key)
+		}
+	}
+	return nil
+}
+
+func (c *CacheConfig) Valid() error {
+	if c == nil {
+		return nil
+	}
+	if c.Ttl < 0 || c.StaleWhileRevalidate < 0 {
+		return errors.New("cache ttl should not be negative")
+	}
+	for _, item := range c.Keys {
+		if item == nil || len(item.Name) <= 0 {
+			return errors.New("cache key name should not be empty")
+		}
+		if _, ok := ValueSource_name[int32(item.Source)]; !ok {
+			return errors.New("invalid cache key source value")
+		}
+	}
+	return nil
+}
+
+func (p *CachePurge) Valid() error {
+	if p.Id == "" {
+		return errors.New("cache purge id should not be empty")
+	}
+	if len(p.ApiId) <= 0 && len(p.Host) <= 0 && len(p.Path) <= 0 {
+		return errors.New("cache purge should specify host, path or apiId")
+	}
+	return nil
+}
+
+func (c *CorsPolicy) Valid() error {
+	if c == nil {
+		return nil
+	}
+	if len(c.AllowOrigins) <= 0 {
+		return errors.New("cors allowOrigins should not be empty")
+	}
+	if c.MaxAge < 0 {
+		return errors.New("cors maxAge should not be negative")
+	}
+	for _, origin := range c.AllowOrigins {
+		if origin == "*" && c.AllowCredentials {
+			return errors.New("cors allowOrigins should not be * when allowCredentials is true")
+		}
+	}
+	return nil
+}
+
+func (g *GrpcTranscode) Valid() error {
+	if g == nil {
+		return nil
+	}
+	if len(g.DescriptorName) <= 0 {
+		return errors.New("grpc descriptorName should not be empty")
+	}
+	if len(g.Method) <= 0 {
+		return errors.New("grpc method should not be empty")
+	}
+	return nil
+}
+
+func (s *TrafficSplit) Valid() error {
+	if s == nil {
+		return nil
+	}
+	if len(s.Cookie) > 0 && len(s.Key) > 0 {
+		return errors.New("split cookie and key should not be set at the same time")
+	}
+	for _, a := range s.Apis {
+		if a == nil || len(a.ApiId) <= 0 {
+			return errors.New("split apiId should not be empty")
+		}
+		if a.Weight < 0 {
+			return errors.New("split weight should not be negative")
+		}
+	}
+	return nil
+}
+
+func (m *Mirror) Valid() error {
+	if m == nil {
+		return nil
+	}
+	if len(m.Service) <= 0 {
+		return errors.New("mirror service should not be empty")
+	}
+	if m.Percent < 0 || m.Percent > 100 {
+		return errors.New("mirror percent should be between 0 and 100")
+	}
+	if m.Timeout < 0 {
+		return errors.New("mirror timeout should not be negative")
+	}
+	return nil
+}
+
+func (v *ApiVersioning) Valid() error {
+	if v == nil {
+		return nil
+	}
+	if _, ok := VersionSource_name[int32(v.Source)]; !ok {
+		return errors.New("invalid versioning source value")
+	}
+	if v.Source == VersionSource_VersionHeader && len(v.Name) <= 0 {
+		return errors.New("versioning header name should not be empty")
+	}
+	return nil
+}
+
+// Valid 日期使用RFC3339格式
+func (d *ApiDeprecation) Valid() error {
+	if d == nil {
+		return nil
+	}
+	if len(d.Date) > 0 {
+		if _, err := time.Parse(time.RFC3339, d.Date); err != nil {
+			return errors.New("invalid deprecation date, " + err.Error())
+		}
+	}
+	if len(d.Sunset) > 0 {
+		if _, err := time.Parse(time.RFC3339, d.Sunset); err != nil {
+			return errors.New("invalid deprecation sunset, " + err.Error())
+		}
+	}
+	return nil
+}
+
+func (d *ProtoDescriptor) Valid() error {
+	if d.Id == "" {
+		return errors.New("proto descriptor id should not be empty")
+	}
+	if d.Name == "" {
+		return errors.New("proto descriptor name should not be empty")
+	}
+	if len(d.Data) <= 0 {
+		return errors.New("proto descriptor data should not be empty")
+	}
+	return nil
+}
+
+func (c *UpstreamClient) Valid() error {
+	if c == nil {
+		return nil
+	}
+	if c.Scheme != "" && c.Scheme != "http" && c.Scheme != "https" {
+		return errors.New("invalid upstream scheme " + c.Scheme)
+	}
+	if (len(c.ClientCert) > 0) != (len(c.ClientKey) > 0) {
+		return errors.New("upstream clientCert and clientKey should be set together")
+	}
+	if c.ConnectTimeout < 0 || c.ReadTimeout < 0 || c.WriteTimeout < 0 || c.IdleConnTimeout < 0 {
+		return errors.New("upstream timeouts should not be negative")
+	}
+	if c.MaxConns < 0 {
+		return errors.New("upstream maxConns should not be negative")
+	}
+	return nil
+}
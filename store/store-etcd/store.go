@@ -16,6 +16,7 @@ const (
 	DefaultDialTimeout      = time.Second * 3
 	DefaultSlowTxnTimeToLog = time.Second * 1
 	DefaultRequestTimeout   = 10 * time.Second
+	DefaultCachePurgeTTL    = 60 * time.Second
 )
 
 type EtcdStore struct {
@@ -30,6 +31,9 @@ type EtcdStore struct {
 	ServiceCfgPath string
 	GatewayPath    string
 	ConfigPath     string
+	IPAclPath      string
+	DescriptorPath string
+	CachePurgePath string
 	client         *clientv3.Client
 }
 
@@ -58,6 +62,9 @@ func NewStore(addrs []string, prefix string, options map[string]interface{}) (*E
 		RoutePath:      fmt.Sprintf("%s/routes/", prefix),
 		ServicePath:    fmt.Sprintf("%s/services/", prefix),
 		ServicePrefix:  fmt.Sprintf("%s/space/", prefix),
+		IPAclPath:      fmt.Sprintf("%s/ipacls/", prefix),
+		DescriptorPath: fmt.Sprintf("%s/descriptors/", prefix),
+		CachePurgePath: fmt.Sprintf("%s/cache_purges/", prefix),
 		ServerPath:     "/svrs/",
 		ApiPath:        "/apis/",
 		ServiceCfgPath: "/cfg",
@@ -355,6 +362,84 @@ func (s *EtcdStore) GetServer(service, id string) (*meta.Server, error) {
 	return m, nil
 }
 
+func (s *EtcdStore) PutIPAcl(acl *meta.IPAcl) error {
+	if len(acl.Id) <= 0 {
+		acl.InitId()
+	}
+	data, err := acl.Marshal()
+	if err != nil {
+		return err
+	}
+	return s.put(s.key(s.IPAclPath, acl.Id), reflectx.BytesToString(data))
+}
+func (s *EtcdStore) RemoveIPAcl(id string) error {
+	return s.delete(s.key(s.IPAclPath, id))
+}
+func (s *EtcdStore) GetIPAcls(handler func(item *meta.IPAcl)) error {
+	return s.gets(s.IPAclPath, func() meta.Serializable { return &meta.IPAcl{} }, func(sb meta.Serializable) {
+		handler(sb.(*meta.IPAcl))
+	})
+}
+func (s *EtcdStore) GetIPAcl(id string) (*meta.IPAcl, error) {
+	resp, err := s.get(s.key(s.IPAclPath, id), clientv3.WithLimit(1))
+	if err != nil {
+		return nil, err
+	}
+	if resp.Count <= 0 {
+		return nil, nil
+	}
+	kv := resp.Kvs[0]
+	m := &meta.IPAcl{Id: id}
+	err = m.Unmarshal(kv.Value)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (s *EtcdStore) PutProtoDescriptor(desc *meta.ProtoDescriptor) error {
+	desc.InitId()
+	data, err := desc.Marshal()
+	if err != nil {
+		return err
+	}
+	return s.put(s.key(s.DescriptorPath, desc.Id), reflectx.BytesToString(data))
+}
+func (s *EtcdStore) RemoveProtoDescriptor(id string) error {
+	return s.delete(s.key(s.DescriptorPath, id))
+}
+func (s *EtcdStore) GetProtoDescriptors(handler func(item *meta.ProtoDescriptor)) error {
+	return s.gets(s.DescriptorPath, func() meta.Serializable { return &meta.ProtoDescriptor{} }, func(sb meta.Serializable) {
+		handler(sb.(*meta.ProtoDescriptor))
+	})
+}
+func (s *EtcdStore) GetProtoDescriptor(id string) (*meta.ProtoDescriptor, error) {
+	resp, err := s.get(s.key(s.DescriptorPath, id), clientv3.WithLimit(1))
+	if err != nil {
+		return nil, err
+	}
+	if resp.Count <= 0 {
+		return nil, nil
+	}
+	kv := resp.Kvs[0]
+	m := &meta.ProtoDescriptor{Id: id}
+	err = m.Unmarshal(kv.Value)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PurgeCache 清除缓存的指令只需要通知到正在运行的网关，所以设置了过期时间
+func (s *EtcdStore) PurgeCache(purge *meta.CachePurge) error {
+	purge.InitId()
+	data, err := purge.Marshal()
+	if err != nil {
+		return err
+	}
+	return s.putWithTTL(s.key(s.CachePurgePath, purge.Id), reflectx.BytesToString(data), DefaultCachePurgeTTL)
+}
+
 func (s *EtcdStore) put(key, value string, opts ...clientv3.OpOption) error {
 	_, err := s.txn().Then(clientv3.OpPut(key, value, opts...)).Commit()
 	return err
@@ -52,6 +52,39 @@ var watchPathHandlers = map[string]func(ln meta.EventListener, op meta.Operation
 		log.Infof("[etcd] [watch] service = %s , name = %s , %s", key, m.Name, op)
 		ln.RecvService(op, m)
 	},
+	"ipacls": func(ln meta.EventListener, op meta.Operation, key string, kv *mvccpb.KeyValue) {
+		m := &meta.IPAcl{Id: key}
+		err := m.Unmarshal(kv.Value)
+		if err != nil || (op != meta.OperationDelete && m.Valid() != nil) {
+			log.Errorf("[etcd] [watch] recv invalid ip acl = %s , %s", key, op)
+			return
+		}
+		log.Infof("[etcd] [watch] ip acl = %s , %s", key, op)
+		ln.RecvIPAcl(op, m)
+	},
+	"descriptors": func(ln meta.EventListener, op meta.Operation, key string, kv *mvccpb.KeyValue) {
+		m := &meta.ProtoDescriptor{Id: key}
+		err := m.Unmarshal(kv.Value)
+		if err != nil || (op != meta.OperationDelete && m.Valid() != nil) {
+			log.Errorf("[etcd] [watch] recv invalid proto descriptor = %s , %s", key, op)
+			return
+		}
+		log.Infof("[etcd] [watch] proto descriptor = %s , %s", key, op)
+		ln.RecvProtoDescriptor(op, m)
+	},
+	"cache_purges": func(ln meta.EventListener, op meta.Operation, key string, kv *mvccpb.KeyValue) {
+		if op != meta.OperationCreate {
+			return
+		}
+		m := &meta.CachePurge{Id: key}
+		err := m.Unmarshal(kv.Value)
+		if err != nil || m.Valid() != nil {
+			log.Errorf("[etcd] [watch] recv invalid cache purge = %s , %s", key, op)
+			return
+		}
+		log.Infof("[etcd] [watch] cache purge = %s , %s", key, op)
+		ln.RecvCachePurge(m)
+	},
 	"space": func(ln meta.EventListener, op meta.Operation, key string, kv *mvccpb.KeyValue) {
 		idx := strings.IndexByte(key, '/')
 		if idx < 0 {
@@ -3,6 +3,8 @@ package file
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -32,18 +34,22 @@ func newServiceCache(s *meta.Service) *serviceCache {
 }
 
 type storeCache struct {
-	Hosts    map[string]*meta.Host
-	Auths    map[string]*meta.Auth
-	Routes   map[string]*meta.Route
-	Services map[string]*serviceCache
+	Hosts       map[string]*meta.Host
+	Auths       map[string]*meta.Auth
+	Routes      map[string]*meta.Route
+	Services    map[string]*serviceCache
+	IPAcls      map[string]*meta.IPAcl
+	Descriptors map[string]*meta.ProtoDescriptor
 }
 
 func newStoreCache() *storeCache {
 	return &storeCache{
-		Hosts:    make(map[string]*meta.Host),
-		Auths:    make(map[string]*meta.Auth),
-		Routes:   make(map[string]*meta.Route),
-		Services: make(map[string]*serviceCache),
+		Hosts:       make(map[string]*meta.Host),
+		Auths:       make(map[string]*meta.Auth),
+		Routes:      make(map[string]*meta.Route),
+		Services:    make(map[string]*serviceCache),
+		IPAcls:      make(map[string]*meta.IPAcl),
+		Descriptors: make(map[string]*meta.ProtoDescriptor),
 	}
 }
 
@@ -57,6 +63,8 @@ type fileContent struct {
 		Apis    []*meta.Api         `mapstructure:"apis"`
 		Svrs    []*meta.Server      `mapstructure:"svrs"`
 	} `mapstructure:"services"`
+	IPAcls      []*meta.IPAcl           `mapstructure:"ipacls"`
+	Descriptors []*meta.ProtoDescriptor `mapstructure:"descriptors"`
 }
 
 // FileStore is readonly store
@@ -208,6 +216,48 @@ func (fs *FileStore) load() (*storeCache, error) {
 			sc.Svrs[s.Id] = s
 		}
 	}
+	for _, v := range content.IPAcls {
+		if v == nil {
+			continue
+		}
+		if len(v.Id) <= 0 {
+			v.InitId()
+		}
+		if err := v.Valid(); err != nil {
+			return nil, fmt.Errorf("invalid ip acl %s, %s", jsonx.Marshal(v), err.Error())
+		}
+		if _, ok := cache.IPAcls[v.Id]; ok {
+			return nil, fmt.Errorf("duplicate ip acl %s", jsonx.Marshal(v))
+		}
+		cache.IPAcls[v.Id] = v
+	}
+	for _, v := range content.Descriptors {
+		if v == nil {
+			continue
+		}
+		if len(v.Id) <= 0 {
+			v.InitId()
+		}
+		if len(v.Data) <= 0 && len(v.File) > 0 {
+			// 描述文件由 protoc --descriptor_set_out 生成，相对路径基于配置文件所在目录
+			file := v.File
+			if !filepath.IsAbs(file) {
+				file = filepath.Join(filepath.Dir(fs.viper.ConfigFileUsed()), file)
+			}
+			data, err := ioutil.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("fail to read proto descriptor %s, %s", v.Name, err.Error())
+			}
+			v.Data = data
+		}
+		if err := v.Valid(); err != nil {
+			return nil, fmt.Errorf("invalid proto descriptor %s, %s", v.Name, err.Error())
+		}
+		if _, ok := cache.Descriptors[v.Id]; ok {
+			return nil, fmt.Errorf("duplicate proto descriptor %s", v.Name)
+		}
+		cache.Descriptors[v.Id] = v
+	}
 	return cache, nil
 }
 
@@ -401,3 +451,53 @@ func (fs *FileStore) GetServer(service, id string) (*meta.Server, error) {
 	}
 	return nil, nil
 }
+
+func (fs *FileStore) PutIPAcl(acl *meta.IPAcl) error {
+	return ErrNotSupportOp
+}
+func (fs *FileStore) RemoveIPAcl(id string) error {
+	return ErrNotSupportOp
+}
+func (fs *FileStore) GetIPAcls(handler func(item *meta.IPAcl)) error {
+	fs.look.RLock()
+	defer fs.look.RUnlock()
+	for _, v := range fs.cache.IPAcls {
+		handler(v.Copy())
+	}
+	return nil
+}
+func (fs *FileStore) GetIPAcl(id string) (*meta.IPAcl, error) {
+	fs.look.RLock()
+	defer fs.look.RUnlock()
+	if v, ok := fs.cache.IPAcls[id]; ok {
+		return v.Copy(), nil
+	}
+	return nil, nil
+}
+
+func (fs *FileStore) PurgeCache(purge *meta.CachePurge) error {
+	return ErrNotSupportOp
+}
+
+func (fs *FileStore) PutProtoDescriptor(desc *meta.ProtoDescriptor) error {
+	return ErrNotSupportOp
+}
+func (fs *FileStore) RemoveProtoDescriptor(id string) error {
+	return ErrNotSupportOp
+}
+func (fs *FileStore) GetProtoDescriptors(handler func(item *meta.ProtoDescriptor)) error {
+	fs.look.RLock()
+	defer fs.look.RUnlock()
+	for _, v := range fs.cache.Descriptors {
+		handler(v.Copy())
+	}
+	return nil
+}
+func (fs *FileStore) GetProtoDescriptor(id string) (*meta.ProtoDescriptor, error) {
+	fs.look.RLock()
+	defer fs.look.RUnlock()
+	if v, ok := fs.cache.Descriptors[id]; ok {
+		return v.Copy(), nil
+	}
+	return nil, nil
+}
@@ -34,6 +34,8 @@ func (fs *FileStore) sendEvents(ln meta.EventListener, cache *storeCache) {
 	fs.sendAuthsEvents(ln, fs.cache.Auths, cache.Auths)
 	fs.sendRoutesEvents(ln, fs.cache.Routes, cache.Routes)
 	fs.sendServicesEvents(ln, fs.cache.Services, cache.Services)
+	fs.sendIPAclsEvents(ln, fs.cache.IPAcls, cache.IPAcls)
+	fs.sendDescriptorsEvents(ln, fs.cache.Descriptors, cache.Descriptors)
 	fs.cache = cache
 }
 func (fs *FileStore) sendHostsEvents(ln meta.EventListener, old, new map[string]*meta.Host) {
@@ -129,6 +131,69 @@ func (fs *FileStore) sendRoutesEvents(ln meta.EventListener, old, new map[string
 		}
 	}
 }
+func (fs *FileStore) sendIPAclsEvents(ln meta.EventListener, old, new map[string]*meta.IPAcl) {
+	if old == nil {
+		if new != nil {
+			for _, item := range new {
+				log.Infof("[file] [watch] ip acl = %s , %s", item.Id, meta.OperationCreate)
+				ln.RecvIPAcl(meta.OperationCreate, item.Copy())
+			}
+		}
+	} else if new == nil {
+		for _, item := range old {
+			log.Infof("[file] [watch] ip acl = %s , %s", item.Id, meta.OperationDelete)
+			ln.RecvIPAcl(meta.OperationDelete, item.Copy())
+		}
+	} else {
+		for id, item := range old {
+			if v, ok := new[id]; ok {
+				log.Infof("[file] [watch] ip acl = %s , %s", v.Id, meta.OperationUpdate)
+				ln.RecvIPAcl(meta.OperationUpdate, v.Copy())
+			} else {
+				log.Infof("[file] [watch] ip acl = %s , %s", item.Id, meta.OperationDelete)
+				ln.RecvIPAcl(meta.OperationDelete, item.Copy())
+			}
+		}
+		for id, item := range new {
+			if _, ok := old[id]; !ok {
+				log.Infof("[file] [watch] ip acl = %s , %s", item.Id, meta.OperationCreate)
+				ln.RecvIPAcl(meta.OperationCreate, item.Copy())
+			}
+		}
+	}
+}
+
+func (fs *FileStore) sendDescriptorsEvents(ln meta.EventListener, old, new map[string]*meta.ProtoDescriptor) {
+	if old == nil {
+		if new != nil {
+			for _, item := range new {
+				log.Infof("[file] [watch] proto descriptor = %s , %s", item.Id, meta.OperationCreate)
+				ln.RecvProtoDescriptor(meta.OperationCreate, item.Copy())
+			}
+		}
+	} else if new == nil {
+		for _, item := range old {
+			log.Infof("[file] [watch] proto descriptor = %s , %s", item.Id, meta.OperationDelete)
+			ln.RecvProtoDescriptor(meta.OperationDelete, item.Copy())
+		}
+	} else {
+		for id, item := range old {
+			if v, ok := new[id]; ok {
+				log.Infof("[file] [watch] proto descriptor = %s , %s", v.Id, meta.OperationUpdate)
+				ln.RecvProtoDescriptor(meta.OperationUpdate, v.Copy())
+			} else {
+				log.Infof("[file] [watch] proto descriptor = %s , %s", item.Id, meta.OperationDelete)
+				ln.RecvProtoDescriptor(meta.OperationDelete, item.Copy())
+			}
+		}
+		for id, item := range new {
+			if _, ok := old[id]; !ok {
+				log.Infof("[file] [watch] proto descriptor = %s , %s", item.Id, meta.OperationCreate)
+				ln.RecvProtoDescriptor(meta.OperationCreate, item.Copy())
+			}
+		}
+	}
+}
 
 func (fs *FileStore) sendServicesEvents(ln meta.EventListener, old, new map[string]*serviceCache) {
 	for service, item := range old {
@@ -46,6 +46,18 @@ func main() {
 	if err != nil {
 		exit(err)
 	}
+	err = s.GetIPAcls(func(item *meta.IPAcl) {
+		fmt.Println("ipacls: ", jsonx.Marshal(item))
+	})
+	if err != nil {
+		exit(err)
+	}
+	err = s.GetProtoDescriptors(func(item *meta.ProtoDescriptor) {
+		fmt.Println("descriptors: ", item.Id, item.Name, len(item.Data))
+	})
+	if err != nil {
+		exit(err)
+	}
 	wg := sync.WaitGroup{}
 	stopCh := make(chan struct{})
 	ln := Ln{}
@@ -77,3 +89,12 @@ func (ln Ln) RecvApi(op meta.Operation, service string, data *meta.Api) {
 func (ln Ln) RecvServer(op meta.Operation, service string, data *meta.Server) {
 	fmt.Println(op, "service server: ", service, jsonx.Marshal(data))
 }
+func (ln Ln) RecvIPAcl(op meta.Operation, data *meta.IPAcl) {
+	fmt.Println(op, "ip acl: ", jsonx.Marshal(data))
+}
+func (ln Ln) RecvCachePurge(data *meta.CachePurge) {
+	fmt.Println("cache purge: ", jsonx.Marshal(data))
+}
+func (ln Ln) RecvProtoDescriptor(op meta.Operation, data *meta.ProtoDescriptor) {
+	fmt.Println(op, "proto descriptor: ", data.Id, data.Name, len(data.Data))
+}
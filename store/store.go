@@ -56,6 +56,18 @@ type Store interface {
 	GetServers(service string, handler func(item *meta.Server)) error
 	GetServer(service, id string) (*meta.Server, error)
 
+	PutIPAcl(acl *meta.IPAcl) error
+	RemoveIPAcl(id string) error
+	GetIPAcls(handler func(item *meta.IPAcl)) error
+	GetIPAcl(id string) (*meta.IPAcl, error)
+
+	PutProtoDescriptor(desc *meta.ProtoDescriptor) error
+	RemoveProtoDescriptor(id string) error
+	GetProtoDescriptors(handler func(item *meta.ProtoDescriptor)) error
+	GetProtoDescriptor(id string) (*meta.ProtoDescriptor, error)
+
+	PurgeCache(purge *meta.CachePurge) error
+
 	Watch(ln meta.EventListener, stopCh <-chan struct{}, waitStop *sync.WaitGroup) error
 }
 